@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/datumforge/entx"
+	"github.com/spf13/cobra"
+
+	"github.com/datumforge/go-template/config"
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/entdb"
+)
+
+// defaultRetention is the time soft deleted objects are kept before they are purged
+const defaultRetention = 30 * 24 * time.Hour
+
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "permanently delete the organizations, users and todos that were soft deleted before the retention window",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfgFile, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		retention, err := cmd.Flags().GetDuration("retention")
+		if err != nil {
+			return err
+		}
+
+		return purge(cmd.Context(), cfgFile, retention)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(purgeCmd)
+
+	purgeCmd.Flags().String("config", config.DefaultConfigFilePath, "config file location")
+	purgeCmd.Flags().Duration("retention", defaultRetention, "soft deleted objects older than the retention are purged")
+}
+
+func purge(ctx context.Context, cfgFile string, retention time.Duration) error {
+	cfg, err := config.Load(&cfgFile)
+	if err != nil {
+		return err
+	}
+
+	// the purge job does not run migrations, the server is responsible for the schema
+	cfg.DB.RunMigrations = false
+
+	entdbClient, _, err := entdb.NewMultiDriverDBClient(ctx, cfg.DB, logger, []ent.Option{ent.Logger(*logger)})
+	if err != nil {
+		return err
+	}
+
+	defer entdbClient.Close()
+
+	// skip the soft delete hook so the objects are removed, mutations are recorded as the system actor
+	// and are not scoped to a single organization
+	ctx = entx.SkipSoftDelete(interceptors.SkipTenant(hooks.WithActor(ctx, hooks.ActorSystem)))
+
+	deletedBefore := time.Now().Add(-retention)
+
+	res, err := purgeDeleted(ctx, entdbClient, deletedBefore)
+	if err != nil {
+		logger.Errorw("failed to purge soft deleted objects", "error", err)
+
+		return err
+	}
+
+	logger.Infow("purged soft deleted objects", "organizations", res.organizations, "users", res.users, "todos", res.todos, "deleted_before", deletedBefore)
+
+	return nil
+}
+
+// purgeResult holds the number of purged objects of each soft deleted schema
+type purgeResult struct {
+	organizations int
+	users         int
+	todos         int
+}
+
+// purgeDeleted removes the organizations, users and todos soft deleted before the time in a single transaction; the
+// memberships of the purged organizations and users are removed with them, as are the todos and tags owned by the
+// purged organizations. The context has to skip soft deletes
+func purgeDeleted(ctx context.Context, client *ent.Client, deletedBefore time.Time) (res purgeResult, err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	orgIDs, err := tx.Organization.Query().
+		Where(organization.DeletedAtNotNil(), organization.DeletedAtLT(deletedBefore)).
+		IDs(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	userIDs, err := tx.User.Query().
+		Where(user.DeletedAtNotNil(), user.DeletedAtLT(deletedBefore)).
+		IDs(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	if _, err = tx.OrgMembership.Delete().
+		Where(orgmembership.Or(orgmembership.OrganizationIDIn(orgIDs...), orgmembership.UserIDIn(userIDs...))).
+		Exec(ctx); err != nil {
+		return res, err
+	}
+
+	if res.todos, err = tx.Todo.Delete().
+		Where(todo.Or(
+			todo.And(todo.DeletedAtNotNil(), todo.DeletedAtLT(deletedBefore)),
+			todo.OwnerIDIn(orgIDs...),
+		)).
+		Exec(ctx); err != nil {
+		return res, err
+	}
+
+	if _, err = tx.Tag.Delete().Where(tag.OwnerIDIn(orgIDs...)).Exec(ctx); err != nil {
+		return res, err
+	}
+
+	if res.organizations, err = tx.Organization.Delete().Where(organization.IDIn(orgIDs...)).Exec(ctx); err != nil {
+		return res, err
+	}
+
+	if res.users, err = tx.User.Delete().Where(user.IDIn(userIDs...)).Exec(ctx); err != nil {
+		return res, err
+	}
+
+	return res, tx.Commit()
+}
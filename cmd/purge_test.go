@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/datumforge/entx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+func TestPurgeDeleted(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	system := interceptors.SkipTenant(context.Background())
+
+	now := time.Now()
+	expired := now.Add(-40 * 24 * time.Hour)
+	recent := now.Add(-24 * time.Hour)
+	deletedBefore := now.Add(-defaultRetention)
+
+	// an organization deleted before the retention window with its member, todo and tag
+	purgedOrg := client.Organization.Create().SetName("initech").SaveX(system)
+	member := client.User.Create().SetEmail("member@initech.com").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(purgedOrg.ID).SetUserID(member.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+	purgedOrgTodo := client.Todo.Create().SetName("initech todo").SaveX(testutils.UserContext(member.ID, purgedOrg.ID))
+	purgedOrgTag := client.Tag.Create().SetName("initech tag").SaveX(testutils.UserContext(member.ID, purgedOrg.ID))
+	client.Organization.UpdateOne(purgedOrg).SetDeletedAt(expired).ExecX(system)
+
+	// an organization with todos deleted before and within the retention window
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	owner := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	activeTodo := client.Todo.Create().SetName("active").SaveX(testutils.UserContext(owner.ID, org.ID))
+	expiredTodo := client.Todo.Create().SetName("expired").SaveX(testutils.UserContext(owner.ID, org.ID))
+	recentTodo := client.Todo.Create().SetName("recent").SaveX(testutils.UserContext(owner.ID, org.ID))
+	client.Todo.UpdateOne(expiredTodo).SetDeletedAt(expired).ExecX(system)
+	client.Todo.UpdateOne(recentTodo).SetDeletedAt(recent).ExecX(system)
+
+	// users deleted before and within the retention window, both members of the organization
+	expiredUser := client.User.Create().SetEmail("expired@acme.com").SaveX(system)
+	recentUser := client.User.Create().SetEmail("recent@acme.com").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(expiredUser.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(recentUser.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+	client.User.UpdateOne(expiredUser).SetDeletedAt(expired).ExecX(system)
+	client.User.UpdateOne(recentUser).SetDeletedAt(recent).ExecX(system)
+
+	ctx := entx.SkipSoftDelete(interceptors.SkipTenant(hooks.WithActor(context.Background(), hooks.ActorSystem)))
+
+	res, err := purgeDeleted(ctx, client, deletedBefore)
+	require.NoError(t, err)
+
+	assert.Equal(t, purgeResult{organizations: 1, users: 1, todos: 2}, res)
+
+	// the objects are looked up including the soft deleted ones
+	all := interceptors.WithDeleted(system)
+
+	orgIDs := client.Organization.Query().IDsX(all)
+	assert.ElementsMatch(t, []string{org.ID}, orgIDs)
+
+	userIDs := client.User.Query().IDsX(all)
+	assert.ElementsMatch(t, []string{member.ID, owner.ID, recentUser.ID}, userIDs)
+
+	todoIDs := client.Todo.Query().IDsX(all)
+	assert.ElementsMatch(t, []string{activeTodo.ID, recentTodo.ID}, todoIDs)
+	assert.NotContains(t, todoIDs, purgedOrgTodo.ID)
+
+	tagIDs := client.Tag.Query().IDsX(system)
+	assert.NotContains(t, tagIDs, purgedOrgTag.ID)
+
+	// only the memberships of the organization and users that were kept remain
+	assert.Equal(t, 2, client.OrgMembership.Query().CountX(system))
+
+	// nothing is left to purge
+	res, err = purgeDeleted(ctx, client, deletedBefore)
+	require.NoError(t, err)
+	assert.Equal(t, purgeResult{}, res)
+}
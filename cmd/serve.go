@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/datumforge/datum/pkg/otelx"
+	"github.com/datumforge/fgax"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -12,6 +13,8 @@ import (
 
 	ent "github.com/datumforge/go-template/internal/ent/generated"
 	"github.com/datumforge/go-template/internal/entdb"
+	"github.com/datumforge/go-template/internal/events"
+	"github.com/datumforge/go-template/internal/graphapi"
 	"github.com/datumforge/go-template/internal/httpserve/config"
 	"github.com/datumforge/go-template/internal/httpserve/server"
 	"github.com/datumforge/go-template/internal/httpserve/serveropts"
@@ -56,6 +59,49 @@ func serve(ctx context.Context) error {
 		logger.Fatalw("failed to initialize tracer", "error", err)
 	}
 
+	// Setup Redis connection
+	redisClient := cache.New(so.Config.Settings.Redis)
+	defer redisClient.Close()
+
+	// the budgets of the graph cost limit are kept in redis, do not start without the limit once it is enabled
+	costLimitRedis := redisClient
+	if !so.Config.Settings.Redis.Enabled {
+		costLimitRedis = nil
+	}
+
+	if err := graphapi.CheckCostLimit(ctx, so.Config.Settings.Server.GraphQL.CostLimit, costLimitRedis); err != nil {
+		return err
+	}
+
+	// Setup the event broker for graphql subscriptions
+	brokerOpts := []events.Option{
+		events.WithLogger(logger.Named("events")),
+		events.WithBufferSize(so.Config.Settings.Events.BufferSize),
+	}
+
+	if so.Config.Settings.Events.UseRedis {
+		brokerOpts = append(brokerOpts, events.WithRedis(redisClient))
+	}
+
+	eventBroker := events.NewBroker(brokerOpts...)
+	defer eventBroker.Close()
+
+	entOpts = append(entOpts, ent.Events(eventBroker))
+
+	// Setup the openFGA client, the store and the authorization model are created when they do not exist yet
+	var fgaClient *fgax.Client
+
+	if so.Config.Settings.Authz.Enabled {
+		fgaClient, err = fgax.CreateFGAClientWithStore(ctx, so.Config.Settings.Authz.FGAConfig(), logger)
+		if err != nil {
+			return err
+		}
+
+		entOpts = append(entOpts, ent.Authz(*fgaClient))
+	} else {
+		logger.Warn("authorization is disabled, the relations of objects are not checked in openFGA")
+	}
+
 	// Setup DB connection
 	entdbClient, dbConfig, err := entdb.NewMultiDriverDBClient(ctx, so.Config.Settings.DB, logger, entOpts)
 	if err != nil {
@@ -64,10 +110,6 @@ func serve(ctx context.Context) error {
 
 	defer entdbClient.Close()
 
-	// Setup Redis connection
-	redisClient := cache.New(so.Config.Settings.Redis)
-	defer redisClient.Close()
-
 	// Add Driver to the Handlers Config
 	so.Config.Handler.DBClient = entdbClient
 
@@ -76,7 +118,12 @@ func serve(ctx context.Context) error {
 
 	// add ready checks
 	so.AddServerOptions(
-		serveropts.WithReadyChecks(dbConfig, redisClient),
+		serveropts.WithReadyChecks(dbConfig, redisClient, fgaClient),
+	)
+
+	// add auth middleware, this has to be added before the session manager
+	so.AddServerOptions(
+		serveropts.WithAuth(redisClient),
 	)
 
 	// add session manager
@@ -11,12 +11,14 @@ import (
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/datumforge/entx"
+	"github.com/datumforge/fgax"
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/env"
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
 	"github.com/mcuadros/go-defaults"
 
+	"github.com/datumforge/go-template/internal/events"
 	"github.com/datumforge/go-template/internal/httpserve/handlers"
 )
 
@@ -42,14 +44,20 @@ type Config struct {
 	Ratelimit ratelimit.Config `json:"ratelimit" koanf:"ratelimit"`
 	// Auth contains the authentication token settings and provider(s)
 	Auth Auth `json:"auth" koanf:"auth"`
+	// Events contains the settings for the event broker used by graphql subscriptions
+	Events events.Config `json:"events" koanf:"events"`
+	// Authz contains the authorization settings for the openFGA service
+	Authz Authz `json:"authz" koanf:"authz"`
 }
 
 // Auth settings including oauth2 providers and datum token configuration
 type Auth struct {
-	// Enabled authentication on the server, not recommended to disable
+	// Enabled authentication on the server, not recommended to disable as every graph request then runs as the same development user
 	Enabled bool `json:"enabled" koanf:"enabled" default:"true"`
 	// Token contains the token config settings for Datum issued tokens
 	Token tokens.Config `json:"token" koanf:"token" jsonschema:"required" alias:"tokenconfig"`
+	// PublicOperations are the root fields of graph operations that can be requested without authentication, e.g. __schema and __type, they run as an anonymous viewer that can not query any data
+	PublicOperations []string `json:"publicOperations" koanf:"publicOperations"`
 	// SupportedProviders are the supported oauth providers that have been configured
 	SupportedProviders []string `json:"supportedProviders" koanf:"supportedProviders"`
 	// Providers contains supported oauth2 providers configuration
@@ -78,6 +86,85 @@ type Server struct {
 	TLS TLS `json:"tls" koanf:"tls"`
 	// CORS contains settings to allow cross origin settings and insecure cookies
 	CORS CORS `json:"cors" koanf:"cors"`
+	// GraphQL contains the settings for the graphql handler
+	GraphQL GraphQL `json:"graphql" koanf:"graphql"`
+}
+
+// GraphQL settings for the graphql handler
+type GraphQL struct {
+	// MaxComplexity is the maximum complexity score allowed for a single operation, 0 disables the limit
+	MaxComplexity int `json:"maxComplexity" koanf:"maxComplexity" default:"1000"`
+	// MaxDepth is the maximum selection depth allowed for a single operation, 0 disables the limit
+	MaxDepth int `json:"maxDepth" koanf:"maxDepth" default:"15"`
+	// MaxBatchSize is the maximum number of objects that can be created in a single bulk mutation, 0 disables the limit
+	MaxBatchSize int `json:"maxBatchSize" koanf:"maxBatchSize" default:"1000"`
+	// MaxPageSize is the maximum number of results returned in a single page of a search, 0 disables the limit
+	MaxPageSize int `json:"maxPageSize" koanf:"maxPageSize" default:"100"`
+	// Admins is a list of user ids allowed to view soft deleted objects with the X-Include-Deleted header and to restore them
+	Admins []string `json:"admins" koanf:"admins"`
+	// CostLimit charges the complexity of each operation against a per user or per ip budget
+	CostLimit CostLimit `json:"costLimit" koanf:"costLimit"`
+	// TrustedDocuments restricts the graphql handler to a list of known operations
+	TrustedDocuments TrustedDocuments `json:"trustedDocuments" koanf:"trustedDocuments"`
+	// PersistedQueries contains the settings for the automatic persisted queries cache
+	PersistedQueries PersistedQueries `json:"persistedQueries" koanf:"persistedQueries"`
+}
+
+// Authz settings for the openFGA service, the same settings as fgax.Config but disabled by default so the server
+// can be started without openFGA, the local service is started with `task docker:fga`
+type Authz struct {
+	// Enabled - checks this first before reading the config
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"description=enables authorization checks with openFGA" default:"false"`
+	// StoreName of the FGA Store
+	StoreName string `json:"storeName" koanf:"storeName" jsonschema:"description=name of openFGA store" default:"datum"`
+	// HostURL of the fga API, replaces Host and Scheme settings
+	HostURL string `json:"hostUrl" koanf:"hostUrl" jsonschema:"description=host url with scheme of the openFGA API,required" default:"http://localhost:8080"`
+	// StoreID of the authorization store in FGA
+	StoreID string `json:"storeId" koanf:"storeId" jsonschema:"description=id of openFGA store"`
+	// ModelID that already exists in authorization store to be used
+	ModelID string `json:"modelId" koanf:"modelId" jsonschema:"description=id of openFGA model"`
+	// CreateNewModel force creates a new model, even if one already exists
+	CreateNewModel bool `json:"createNewModel" koanf:"createNewModel" jsonschema:"description=force create a new model, even if one already exists" default:"false"`
+	// ModelFile is the path to the model file
+	ModelFile string `json:"modelFile" koanf:"modelFile" jsonschema:"description=path to the fga model file" default:"fga/model/datum.fga"`
+	// Credentials for the client
+	Credentials fgax.Credentials `json:"credentials" koanf:"credentials" jsonschema:"description=credentials for the openFGA client"`
+}
+
+// FGAConfig returns the settings as the config of the openFGA client
+func (a Authz) FGAConfig() fgax.Config {
+	return fgax.Config(a)
+}
+
+// CostLimit settings for the cost based rate limit of graphql operations, the budget is stored in redis so
+// the server does not start with the limit enabled when redis is disabled or can not be reached
+type CostLimit struct {
+	// Enabled turns on the cost based rate limit
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Budget is the maximum complexity a user or ip can spend before being rate limited
+	Budget int `json:"budget" koanf:"budget" default:"10000"`
+	// RefillRate is the complexity added back to the budget each second
+	RefillRate float64 `json:"refillRate" koanf:"refillRate" default:"100"`
+	// FailClosed rejects operations while the budget can not be checked in redis instead of allowing them
+	FailClosed bool `json:"failClosed" koanf:"failClosed" default:"false"`
+}
+
+// TrustedDocuments settings for the persisted query allow-list
+type TrustedDocuments struct {
+	// Enabled rejects any operation that is not in the list of trusted documents
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Directory containing the .graphql files with the trusted operations
+	Directory string `json:"directory" koanf:"directory" default:"./query"`
+	// Manifest is the path to a JSON file mapping the sha256 hash of each document to the document, takes precedence over the directory
+	Manifest string `json:"manifest" koanf:"manifest"`
+}
+
+// PersistedQueries settings for the automatic persisted queries cache
+type PersistedQueries struct {
+	// UseRedis stores the persisted queries in redis so they are shared across server replicas
+	UseRedis bool `json:"useRedis" koanf:"useRedis" default:"false"`
+	// TTL is the time persisted queries are kept in redis
+	TTL time.Duration `json:"ttl" koanf:"ttl" default:"24h"`
 }
 
 // CORS settings for the server to allow cross origin requests
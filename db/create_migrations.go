@@ -21,6 +21,7 @@ import (
 	"ariga.io/atlas/sql/sqltool"
 	"github.com/datumforge/datum/pkg/testutils"
 	"github.com/datumforge/go-template/internal/ent/generated/migrate"
+	"github.com/datumforge/go-template/internal/ent/search"
 )
 
 func main() {
@@ -47,6 +48,7 @@ func main() {
 		schema.WithMigrationMode(schema.ModeReplay), // provide migration mode
 		schema.WithDropColumn(true),
 		schema.WithDropIndex(true),
+		schema.WithDiffHook(search.DiffHook), // the search indexes are not part of the ent schema
 	}
 
 	sqliteOpts := append(baseOpts, schema.WithDialect(dialect.SQLite))
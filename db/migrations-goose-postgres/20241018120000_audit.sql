@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "created_at" timestamptz NULL, ADD COLUMN "updated_at" timestamptz NULL, ADD COLUMN "created_by" character varying NULL, ADD COLUMN "updated_by" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "todos" table
+ALTER TABLE "todos" DROP COLUMN "updated_by", DROP COLUMN "created_by", DROP COLUMN "updated_at", DROP COLUMN "created_at";
@@ -0,0 +1,15 @@
+-- +goose Up
+-- modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_by" character varying NULL;
+-- drop index "todo_name" from table: "todos"
+DROP INDEX "todo_name";
+-- create index "todo_name" to table: "todos"
+CREATE UNIQUE INDEX "todo_name" ON "todos" ("name") WHERE (deleted_at IS NULL);
+
+-- +goose Down
+-- reverse: create index "todo_name" to table: "todos"
+DROP INDEX "todo_name";
+-- reverse: drop index "todo_name" from table: "todos"
+CREATE UNIQUE INDEX "todo_name" ON "todos" ("name");
+-- reverse: modify "todos" table
+ALTER TABLE "todos" DROP COLUMN "deleted_by", DROP COLUMN "deleted_at";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- create "todo_history" table
+CREATE TABLE "todo_history" ("id" character varying NOT NULL, "history_time" timestamptz NOT NULL, "ref" character varying NULL, "operation" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "name" character varying NOT NULL, "description" character varying NULL, PRIMARY KEY ("id"));
+-- create index "todohistory_history_time" to table: "todo_history"
+CREATE INDEX "todohistory_history_time" ON "todo_history" ("history_time");
+
+-- +goose Down
+-- reverse: create index "todohistory_history_time" to table: "todo_history"
+DROP INDEX "todohistory_history_time";
+-- reverse: create "todo_history" table
+DROP TABLE "todo_history";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "todo_history" table
+ALTER TABLE "todo_history" ADD COLUMN "status" character varying NOT NULL DEFAULT 'OPEN', ADD COLUMN "priority" bigint NOT NULL DEFAULT 0, ADD COLUMN "due_date" timestamptz NULL, ADD COLUMN "completed_at" timestamptz NULL;
+-- modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "status" character varying NOT NULL DEFAULT 'OPEN', ADD COLUMN "priority" bigint NOT NULL DEFAULT 0, ADD COLUMN "due_date" timestamptz NULL, ADD COLUMN "completed_at" timestamptz NULL;
+
+-- +goose Down
+-- reverse: modify "todos" table
+ALTER TABLE "todos" DROP COLUMN "completed_at", DROP COLUMN "due_date", DROP COLUMN "priority", DROP COLUMN "status";
+-- reverse: modify "todo_history" table
+ALTER TABLE "todo_history" DROP COLUMN "completed_at", DROP COLUMN "due_date", DROP COLUMN "priority", DROP COLUMN "status";
@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "tags" table
+CREATE TABLE "tags" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "name" character varying NOT NULL, "color" character varying NULL, "description" character varying NULL, "owner_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "tags_organizations_tags" FOREIGN KEY ("owner_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- create index "tag_owner_id_name" to table: "tags"
+CREATE UNIQUE INDEX "tag_owner_id_name" ON "tags" ("owner_id", "name");
+-- create "todo_tags" table
+CREATE TABLE "todo_tags" ("todo_id" character varying NOT NULL, "tag_id" character varying NOT NULL, PRIMARY KEY ("todo_id", "tag_id"), CONSTRAINT "todo_tags_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "todo_tags_todo_id" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+
+-- +goose Down
+-- reverse: create "todo_tags" table
+DROP TABLE "todo_tags";
+-- reverse: create index "tag_owner_id_name" to table: "tags"
+DROP INDEX "tag_owner_id_name";
+-- reverse: create "tags" table
+DROP TABLE "tags";
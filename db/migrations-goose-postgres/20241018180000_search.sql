@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "todos" table, the search column is not part of the ent schema
+ALTER TABLE "todos" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english', coalesce("name", '')), 'A') || setweight(to_tsvector('english', coalesce("description", '')), 'B')) STORED;
+-- create index "todo_search_vector" to table: "todos"
+CREATE INDEX "todo_search_vector" ON "todos" USING GIN ("search_vector");
+
+-- +goose Down
+-- reverse: create index "todo_search_vector" to table: "todos"
+DROP INDEX "todo_search_vector";
+-- reverse: modify "todos" table
+ALTER TABLE "todos" DROP COLUMN "search_vector";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "todo_history" table
+ALTER TABLE "todo_history" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+
+-- +goose Down
+-- reverse: modify "todos" table
+ALTER TABLE "todos" DROP COLUMN "version";
+-- reverse: modify "todo_history" table
+ALTER TABLE "todo_history" DROP COLUMN "version";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "password" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "password";
@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "created_at" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `created_at` datetime NULL;
+-- add column "updated_at" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `updated_at` datetime NULL;
+-- add column "created_by" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `created_by` text NULL;
+-- add column "updated_by" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `updated_by` text NULL;
+
+-- +goose Down
+-- reverse: add column "updated_by" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `updated_by`;
+-- reverse: add column "created_by" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `created_by`;
+-- reverse: add column "updated_at" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `updated_at`;
+-- reverse: add column "created_at" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `created_at`;
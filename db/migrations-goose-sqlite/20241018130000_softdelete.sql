@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "deleted_at" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `deleted_at` datetime NULL;
+-- add column "deleted_by" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `deleted_by` text NULL;
+-- drop index "todo_name" from table: "todos"
+DROP INDEX `todo_name`;
+-- create index "todo_name" to table: "todos"
+CREATE UNIQUE INDEX `todo_name` ON `todos` (`name`) WHERE deleted_at is NULL;
+
+-- +goose Down
+-- reverse: create index "todo_name" to table: "todos"
+DROP INDEX `todo_name`;
+-- reverse: drop index "todo_name" from table: "todos"
+CREATE UNIQUE INDEX `todo_name` ON `todos` (`name`);
+-- reverse: add column "deleted_by" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `deleted_by`;
+-- reverse: add column "deleted_at" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `deleted_at`;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- create "todo_history" table
+CREATE TABLE `todo_history` (`id` text NOT NULL, `history_time` datetime NOT NULL, `ref` text NULL, `operation` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `name` text NOT NULL, `description` text NULL, PRIMARY KEY (`id`));
+-- create index "todohistory_history_time" to table: "todo_history"
+CREATE INDEX `todohistory_history_time` ON `todo_history` (`history_time`);
+
+-- +goose Down
+-- reverse: create index "todohistory_history_time" to table: "todo_history"
+DROP INDEX `todohistory_history_time`;
+-- reverse: create "todo_history" table
+DROP TABLE `todo_history`;
@@ -0,0 +1,35 @@
+-- +goose Up
+-- add column "status" to table: "todo_history"
+ALTER TABLE `todo_history` ADD COLUMN `status` text NOT NULL DEFAULT ('OPEN');
+-- add column "priority" to table: "todo_history"
+ALTER TABLE `todo_history` ADD COLUMN `priority` integer NOT NULL DEFAULT (0);
+-- add column "due_date" to table: "todo_history"
+ALTER TABLE `todo_history` ADD COLUMN `due_date` datetime NULL;
+-- add column "completed_at" to table: "todo_history"
+ALTER TABLE `todo_history` ADD COLUMN `completed_at` datetime NULL;
+-- add column "status" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `status` text NOT NULL DEFAULT ('OPEN');
+-- add column "priority" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `priority` integer NOT NULL DEFAULT (0);
+-- add column "due_date" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `due_date` datetime NULL;
+-- add column "completed_at" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `completed_at` datetime NULL;
+
+-- +goose Down
+-- reverse: add column "completed_at" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `completed_at`;
+-- reverse: add column "due_date" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `due_date`;
+-- reverse: add column "priority" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `priority`;
+-- reverse: add column "status" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `status`;
+-- reverse: add column "completed_at" to table: "todo_history"
+ALTER TABLE `todo_history` DROP COLUMN `completed_at`;
+-- reverse: add column "due_date" to table: "todo_history"
+ALTER TABLE `todo_history` DROP COLUMN `due_date`;
+-- reverse: add column "priority" to table: "todo_history"
+ALTER TABLE `todo_history` DROP COLUMN `priority`;
+-- reverse: add column "status" to table: "todo_history"
+ALTER TABLE `todo_history` DROP COLUMN `status`;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- create "organizations" table
+CREATE TABLE `organizations` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `name` text NOT NULL, `description` text NULL, PRIMARY KEY (`id`));
+-- create index "organization_name" to table: "organizations"
+CREATE UNIQUE INDEX `organization_name` ON `organizations` (`name`) WHERE deleted_at is NULL;
+-- create "users" table
+CREATE TABLE `users` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `email` text NOT NULL, `display_name` text NULL, PRIMARY KEY (`id`));
+-- create index "user_email" to table: "users"
+CREATE UNIQUE INDEX `user_email` ON `users` (`email`) WHERE deleted_at is NULL;
+-- create "org_memberships" table
+CREATE TABLE `org_memberships` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `role` text NOT NULL DEFAULT ('MEMBER'), `organization_id` text NOT NULL, `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `org_memberships_organizations_members` FOREIGN KEY (`organization_id`) REFERENCES `organizations` (`id`) ON DELETE NO ACTION, CONSTRAINT `org_memberships_users_memberships` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "orgmembership_user_id_organization_id" to table: "org_memberships"
+CREATE UNIQUE INDEX `orgmembership_user_id_organization_id` ON `org_memberships` (`user_id`, `organization_id`);
+-- add column "owner_id" to table: "todo_history"
+ALTER TABLE `todo_history` ADD COLUMN `owner_id` text NULL;
+-- add column "owner_id" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `owner_id` text NULL REFERENCES `organizations` (`id`) ON DELETE SET NULL;
+-- drop index "todo_name" from table: "todos"
+DROP INDEX `todo_name`;
+-- create index "todo_owner_id_name" to table: "todos"
+CREATE UNIQUE INDEX `todo_owner_id_name` ON `todos` (`owner_id`, `name`) WHERE deleted_at is NULL;
+
+-- +goose Down
+-- reverse: create index "todo_owner_id_name" to table: "todos"
+DROP INDEX `todo_owner_id_name`;
+-- reverse: drop index "todo_name" from table: "todos"
+CREATE UNIQUE INDEX `todo_name` ON `todos` (`name`) WHERE deleted_at is NULL;
+-- reverse: add column "owner_id" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `owner_id`;
+-- reverse: add column "owner_id" to table: "todo_history"
+ALTER TABLE `todo_history` DROP COLUMN `owner_id`;
+-- reverse: create index "orgmembership_user_id_organization_id" to table: "org_memberships"
+DROP INDEX `orgmembership_user_id_organization_id`;
+-- reverse: create "org_memberships" table
+DROP TABLE `org_memberships`;
+-- reverse: create index "user_email" to table: "users"
+DROP INDEX `user_email`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create index "organization_name" to table: "organizations"
+DROP INDEX `organization_name`;
+-- reverse: create "organizations" table
+DROP TABLE `organizations`;
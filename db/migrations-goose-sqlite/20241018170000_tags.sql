@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "tags" table
+CREATE TABLE `tags` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `name` text NOT NULL, `color` text NULL, `description` text NULL, `owner_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `tags_organizations_tags` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE SET NULL);
+-- create index "tag_owner_id_name" to table: "tags"
+CREATE UNIQUE INDEX `tag_owner_id_name` ON `tags` (`owner_id`, `name`);
+-- create "todo_tags" table
+CREATE TABLE `todo_tags` (`todo_id` text NOT NULL, `tag_id` text NOT NULL, PRIMARY KEY (`todo_id`, `tag_id`), CONSTRAINT `todo_tags_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`) ON DELETE CASCADE, CONSTRAINT `todo_tags_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`) ON DELETE CASCADE);
+
+-- +goose Down
+-- reverse: create "todo_tags" table
+DROP TABLE `todo_tags`;
+-- reverse: create index "tag_owner_id_name" to table: "tags"
+DROP INDEX `tag_owner_id_name`;
+-- reverse: create "tags" table
+DROP TABLE `tags`;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- create "todos_fts" table storing the id of the todos, the search table is not part of the ent schema
+CREATE VIRTUAL TABLE `todos_fts` USING fts5(`id` UNINDEXED, `name`, `description`);
+-- create triggers keeping "todos_fts" in sync with "todos"
+-- +goose StatementBegin
+CREATE TRIGGER `todos_fts_insert` AFTER INSERT ON `todos` BEGIN
+  INSERT INTO `todos_fts` (`id`, `name`, `description`) VALUES (new.`id`, new.`name`, new.`description`);
+END;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER `todos_fts_delete` AFTER DELETE ON `todos` BEGIN
+  DELETE FROM `todos_fts` WHERE `id` = old.`id`;
+END;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER `todos_fts_update` AFTER UPDATE ON `todos` BEGIN
+  UPDATE `todos_fts` SET `name` = new.`name`, `description` = new.`description` WHERE `id` = old.`id`;
+END;
+-- +goose StatementEnd
+-- index the existing todos
+INSERT INTO `todos_fts` (`id`, `name`, `description`) SELECT `id`, `name`, `description` FROM `todos`;
+
+-- +goose Down
+-- reverse: create triggers keeping "todos_fts" in sync with "todos"
+DROP TRIGGER `todos_fts_update`;
+DROP TRIGGER `todos_fts_delete`;
+DROP TRIGGER `todos_fts_insert`;
+-- reverse: create "todos_fts" table storing the id of the todos
+DROP TABLE `todos_fts`;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "version" to table: "todo_history"
+ALTER TABLE `todo_history` ADD COLUMN `version` integer NOT NULL DEFAULT (1);
+-- add column "version" to table: "todos"
+ALTER TABLE `todos` ADD COLUMN `version` integer NOT NULL DEFAULT (1);
+
+-- +goose Down
+-- reverse: add column "version" to table: "todos"
+ALTER TABLE `todos` DROP COLUMN `version`;
+-- reverse: add column "version" to table: "todo_history"
+ALTER TABLE `todo_history` DROP COLUMN `version`;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "password" to table: "users"
+ALTER TABLE `users` ADD COLUMN `password` text NULL;
+
+-- +goose Down
+-- reverse: add column "password" to table: "users"
+ALTER TABLE `users` DROP COLUMN `password`;
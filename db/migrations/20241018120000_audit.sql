@@ -0,0 +1,2 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "created_at" timestamptz NULL, ADD COLUMN "updated_at" timestamptz NULL, ADD COLUMN "created_by" character varying NULL, ADD COLUMN "updated_by" character varying NULL;
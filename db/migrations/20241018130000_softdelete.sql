@@ -0,0 +1,6 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_by" character varying NULL;
+-- Drop index "todo_name" from table: "todos"
+DROP INDEX "todo_name";
+-- Create index "todo_name" to table: "todos"
+CREATE UNIQUE INDEX "todo_name" ON "todos" ("name") WHERE (deleted_at IS NULL);
@@ -0,0 +1,4 @@
+-- Modify "todo_history" table
+ALTER TABLE "todo_history" ADD COLUMN "status" character varying NOT NULL DEFAULT 'OPEN', ADD COLUMN "priority" bigint NOT NULL DEFAULT 0, ADD COLUMN "due_date" timestamptz NULL, ADD COLUMN "completed_at" timestamptz NULL;
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "status" character varying NOT NULL DEFAULT 'OPEN', ADD COLUMN "priority" bigint NOT NULL DEFAULT 0, ADD COLUMN "due_date" timestamptz NULL, ADD COLUMN "completed_at" timestamptz NULL;
@@ -0,0 +1,20 @@
+-- Create "organizations" table
+CREATE TABLE "organizations" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "name" character varying NOT NULL, "description" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "organization_name" to table: "organizations"
+CREATE UNIQUE INDEX "organization_name" ON "organizations" ("name") WHERE (deleted_at IS NULL);
+-- Create "users" table
+CREATE TABLE "users" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "email" character varying NOT NULL, "display_name" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "user_email" to table: "users"
+CREATE UNIQUE INDEX "user_email" ON "users" ("email") WHERE (deleted_at IS NULL);
+-- Create "org_memberships" table
+CREATE TABLE "org_memberships" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "role" character varying NOT NULL DEFAULT 'MEMBER', "organization_id" character varying NOT NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "org_memberships_organizations_members" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "org_memberships_users_memberships" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "orgmembership_user_id_organization_id" to table: "org_memberships"
+CREATE UNIQUE INDEX "orgmembership_user_id_organization_id" ON "org_memberships" ("user_id", "organization_id");
+-- Modify "todo_history" table
+ALTER TABLE "todo_history" ADD COLUMN "owner_id" character varying NULL;
+-- Drop index "todo_name" from table: "todos"
+DROP INDEX "todo_name";
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "owner_id" character varying NULL, ADD CONSTRAINT "todos_organizations_todos" FOREIGN KEY ("owner_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "todo_owner_id_name" to table: "todos"
+CREATE UNIQUE INDEX "todo_owner_id_name" ON "todos" ("owner_id", "name") WHERE (deleted_at IS NULL);
@@ -0,0 +1,4 @@
+-- Modify "todos" table, the search column is not part of the ent schema
+ALTER TABLE "todos" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english', coalesce("name", '')), 'A') || setweight(to_tsvector('english', coalesce("description", '')), 'B')) STORED;
+-- Create index "todo_search_vector" to table: "todos"
+CREATE INDEX "todo_search_vector" ON "todos" USING GIN ("search_vector");
@@ -0,0 +1,4 @@
+-- Modify "todo_history" table
+ALTER TABLE "todo_history" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,38 @@
+//go:build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/datumforge/go-template/internal/graphapi"
+)
+
+// read in the operations from the query directory and save the trusted documents manifest
+func main() {
+	manifest := map[string]string{}
+
+	td, err := graphapi.NewTrustedDocumentsFromDir("query")
+
+	switch {
+	case errors.Is(err, graphapi.ErrNoTrustedDocuments):
+		// the manifest is still written so the task succeeds before the clients add their operations
+		log.Println("warning: no operations found in the query directory, the trusted documents manifest is empty and the server does not start with trusted documents enabled until operations are added")
+	case err != nil:
+		log.Fatal(err)
+	default:
+		manifest = td.Manifest()
+	}
+
+	out, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("trusted-documents.json", out, 0600); err != nil { // nolint:mnd
+		log.Fatal(err)
+	}
+}
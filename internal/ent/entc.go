@@ -18,15 +18,33 @@ import (
 	"go.uber.org/zap"
 	"gocloud.dev/secrets"
 
+	"github.com/datumforge/enthistory"
 	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/events"
 )
 
 var (
 	graphSchemaDir = "./schema/"
 	// graphQueryDir  = "./query/" // uncomment to enable query generation
+	schemaPath = "./internal/ent/schema"
 )
 
 func main() {
+	// generate the history schemas, the history is written by the hooks in the hooks package and can only
+	// be queried by the viewers with the relation to the organization of the objects
+	historyExt := enthistory.New(
+		enthistory.WithHistoryTimeIndex(),
+		enthistory.WithGQLQuery(),
+		enthistory.WithSchemaPath(schemaPath),
+		enthistory.WithAuthzPolicy(),
+		enthistory.WithAllowedRelation(fgax.CanView),
+	)
+
+	if err := historyExt.GenerateSchemas(); err != nil {
+		log.Fatalf("generating history schema: %v", err)
+	}
+
 	xExt, err := entx.NewExtension(
 		entx.WithJSONScalar(),
 	)
@@ -50,7 +68,7 @@ func main() {
 		log.Fatalf("creating entgql extension: %v", err)
 	}
 
-	if err := entc.Generate("./internal/ent/schema", &gen.Config{
+	if err := entc.Generate(schemaPath, &gen.Config{
 		Target:    "./internal/ent/generated",
 		Templates: entgql.AllTemplates,
 		Hooks: []gen.Hook{
@@ -66,6 +84,7 @@ func main() {
 			gen.FeatureNamedEdges,
 			gen.FeatureSchemaConfig,
 			gen.FeatureIntercept,
+			gen.FeatureModifier,
 		},
 	},
 		entc.Dependency(
@@ -82,6 +101,10 @@ func main() {
 		entc.Dependency(
 			entc.DependencyType(&http.Client{}),
 		),
+		entc.Dependency(
+			entc.DependencyName("Events"),
+			entc.DependencyType(&events.Broker{}),
+		),
 		entc.Extensions(
 			gqlExt,
 			entfga.New(
@@ -12,7 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
 	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			todo.Table: todo.ValidColumn,
+			orgmembership.Table: orgmembership.ValidColumn,
+			organization.Table:  organization.ValidColumn,
+			tag.Table:           tag.ValidColumn,
+			todo.Table:          todo.ValidColumn,
+			todohistory.Table:   todohistory.ValidColumn,
+			user.Table:          user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,7 +3,13 @@
 package generated
 
 import (
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
 	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -13,8 +19,70 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 1)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 6)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   orgmembership.Table,
+			Columns: orgmembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: orgmembership.FieldID,
+			},
+		},
+		Type: "OrgMembership",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			orgmembership.FieldCreatedAt:      {Type: field.TypeTime, Column: orgmembership.FieldCreatedAt},
+			orgmembership.FieldUpdatedAt:      {Type: field.TypeTime, Column: orgmembership.FieldUpdatedAt},
+			orgmembership.FieldCreatedBy:      {Type: field.TypeString, Column: orgmembership.FieldCreatedBy},
+			orgmembership.FieldUpdatedBy:      {Type: field.TypeString, Column: orgmembership.FieldUpdatedBy},
+			orgmembership.FieldRole:           {Type: field.TypeEnum, Column: orgmembership.FieldRole},
+			orgmembership.FieldOrganizationID: {Type: field.TypeString, Column: orgmembership.FieldOrganizationID},
+			orgmembership.FieldUserID:         {Type: field.TypeString, Column: orgmembership.FieldUserID},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   organization.Table,
+			Columns: organization.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: organization.FieldID,
+			},
+		},
+		Type: "Organization",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			organization.FieldCreatedAt:   {Type: field.TypeTime, Column: organization.FieldCreatedAt},
+			organization.FieldUpdatedAt:   {Type: field.TypeTime, Column: organization.FieldUpdatedAt},
+			organization.FieldCreatedBy:   {Type: field.TypeString, Column: organization.FieldCreatedBy},
+			organization.FieldUpdatedBy:   {Type: field.TypeString, Column: organization.FieldUpdatedBy},
+			organization.FieldDeletedAt:   {Type: field.TypeTime, Column: organization.FieldDeletedAt},
+			organization.FieldDeletedBy:   {Type: field.TypeString, Column: organization.FieldDeletedBy},
+			organization.FieldName:        {Type: field.TypeString, Column: organization.FieldName},
+			organization.FieldDescription: {Type: field.TypeString, Column: organization.FieldDescription},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tag.Table,
+			Columns: tag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: tag.FieldID,
+			},
+		},
+		Type: "Tag",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tag.FieldCreatedAt:   {Type: field.TypeTime, Column: tag.FieldCreatedAt},
+			tag.FieldUpdatedAt:   {Type: field.TypeTime, Column: tag.FieldUpdatedAt},
+			tag.FieldCreatedBy:   {Type: field.TypeString, Column: tag.FieldCreatedBy},
+			tag.FieldUpdatedBy:   {Type: field.TypeString, Column: tag.FieldUpdatedBy},
+			tag.FieldOwnerID:     {Type: field.TypeString, Column: tag.FieldOwnerID},
+			tag.FieldName:        {Type: field.TypeString, Column: tag.FieldName},
+			tag.FieldColor:       {Type: field.TypeString, Column: tag.FieldColor},
+			tag.FieldDescription: {Type: field.TypeString, Column: tag.FieldDescription},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   todo.Table,
 			Columns: todo.Columns,
@@ -25,10 +93,194 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Todo",
 		Fields: map[string]*sqlgraph.FieldSpec{
+			todo.FieldCreatedAt:   {Type: field.TypeTime, Column: todo.FieldCreatedAt},
+			todo.FieldUpdatedAt:   {Type: field.TypeTime, Column: todo.FieldUpdatedAt},
+			todo.FieldCreatedBy:   {Type: field.TypeString, Column: todo.FieldCreatedBy},
+			todo.FieldUpdatedBy:   {Type: field.TypeString, Column: todo.FieldUpdatedBy},
+			todo.FieldDeletedAt:   {Type: field.TypeTime, Column: todo.FieldDeletedAt},
+			todo.FieldDeletedBy:   {Type: field.TypeString, Column: todo.FieldDeletedBy},
+			todo.FieldOwnerID:     {Type: field.TypeString, Column: todo.FieldOwnerID},
 			todo.FieldName:        {Type: field.TypeString, Column: todo.FieldName},
 			todo.FieldDescription: {Type: field.TypeString, Column: todo.FieldDescription},
+			todo.FieldStatus:      {Type: field.TypeEnum, Column: todo.FieldStatus},
+			todo.FieldPriority:    {Type: field.TypeInt, Column: todo.FieldPriority},
+			todo.FieldDueDate:     {Type: field.TypeTime, Column: todo.FieldDueDate},
+			todo.FieldCompletedAt: {Type: field.TypeTime, Column: todo.FieldCompletedAt},
+			todo.FieldVersion:     {Type: field.TypeInt, Column: todo.FieldVersion},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   todohistory.Table,
+			Columns: todohistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: todohistory.FieldID,
+			},
+		},
+		Type: "TodoHistory",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			todohistory.FieldHistoryTime: {Type: field.TypeTime, Column: todohistory.FieldHistoryTime},
+			todohistory.FieldRef:         {Type: field.TypeString, Column: todohistory.FieldRef},
+			todohistory.FieldOperation:   {Type: field.TypeEnum, Column: todohistory.FieldOperation},
+			todohistory.FieldCreatedAt:   {Type: field.TypeTime, Column: todohistory.FieldCreatedAt},
+			todohistory.FieldUpdatedAt:   {Type: field.TypeTime, Column: todohistory.FieldUpdatedAt},
+			todohistory.FieldCreatedBy:   {Type: field.TypeString, Column: todohistory.FieldCreatedBy},
+			todohistory.FieldUpdatedBy:   {Type: field.TypeString, Column: todohistory.FieldUpdatedBy},
+			todohistory.FieldDeletedAt:   {Type: field.TypeTime, Column: todohistory.FieldDeletedAt},
+			todohistory.FieldDeletedBy:   {Type: field.TypeString, Column: todohistory.FieldDeletedBy},
+			todohistory.FieldOwnerID:     {Type: field.TypeString, Column: todohistory.FieldOwnerID},
+			todohistory.FieldName:        {Type: field.TypeString, Column: todohistory.FieldName},
+			todohistory.FieldDescription: {Type: field.TypeString, Column: todohistory.FieldDescription},
+			todohistory.FieldStatus:      {Type: field.TypeEnum, Column: todohistory.FieldStatus},
+			todohistory.FieldPriority:    {Type: field.TypeInt, Column: todohistory.FieldPriority},
+			todohistory.FieldDueDate:     {Type: field.TypeTime, Column: todohistory.FieldDueDate},
+			todohistory.FieldCompletedAt: {Type: field.TypeTime, Column: todohistory.FieldCompletedAt},
+			todohistory.FieldVersion:     {Type: field.TypeInt, Column: todohistory.FieldVersion},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   user.Table,
+			Columns: user.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: user.FieldID,
+			},
+		},
+		Type: "User",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			user.FieldCreatedAt:   {Type: field.TypeTime, Column: user.FieldCreatedAt},
+			user.FieldUpdatedAt:   {Type: field.TypeTime, Column: user.FieldUpdatedAt},
+			user.FieldCreatedBy:   {Type: field.TypeString, Column: user.FieldCreatedBy},
+			user.FieldUpdatedBy:   {Type: field.TypeString, Column: user.FieldUpdatedBy},
+			user.FieldDeletedAt:   {Type: field.TypeTime, Column: user.FieldDeletedAt},
+			user.FieldDeletedBy:   {Type: field.TypeString, Column: user.FieldDeletedBy},
+			user.FieldEmail:       {Type: field.TypeString, Column: user.FieldEmail},
+			user.FieldDisplayName: {Type: field.TypeString, Column: user.FieldDisplayName},
+			user.FieldPassword:    {Type: field.TypeString, Column: user.FieldPassword},
 		},
 	}
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orgmembership.OrganizationTable,
+			Columns: []string{orgmembership.OrganizationColumn},
+			Bidi:    false,
+		},
+		"OrgMembership",
+		"Organization",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orgmembership.UserTable,
+			Columns: []string{orgmembership.UserColumn},
+			Bidi:    false,
+		},
+		"OrgMembership",
+		"User",
+	)
+	graph.MustAddE(
+		"members",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.MembersTable,
+			Columns: []string{organization.MembersColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"OrgMembership",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TodosTable,
+			Columns: []string{organization.TodosColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"Todo",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TagsTable,
+			Columns: []string{organization.TagsColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"Tag",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.OwnerTable,
+			Columns: []string{tag.OwnerColumn},
+			Bidi:    false,
+		},
+		"Tag",
+		"Organization",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.TodosTable,
+			Columns: tag.TodosPrimaryKey,
+			Bidi:    false,
+		},
+		"Tag",
+		"Todo",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OwnerTable,
+			Columns: []string{todo.OwnerColumn},
+			Bidi:    false,
+		},
+		"Todo",
+		"Organization",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+		},
+		"Todo",
+		"Tag",
+	)
+	graph.MustAddE(
+		"memberships",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"OrgMembership",
+	)
 	return graph
 }()
 
@@ -38,6 +290,339 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (omq *OrgMembershipQuery) addPredicate(pred func(s *sql.Selector)) {
+	omq.predicates = append(omq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OrgMembershipQuery builder.
+func (omq *OrgMembershipQuery) Filter() *OrgMembershipFilter {
+	return &OrgMembershipFilter{config: omq.config, predicateAdder: omq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OrgMembershipMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OrgMembershipMutation builder.
+func (m *OrgMembershipMutation) Filter() *OrgMembershipFilter {
+	return &OrgMembershipFilter{config: m.config, predicateAdder: m}
+}
+
+// OrgMembershipFilter provides a generic filtering capability at runtime for OrgMembershipQuery.
+type OrgMembershipFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OrgMembershipFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OrgMembershipFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OrgMembershipFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(orgmembership.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OrgMembershipFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(orgmembership.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OrgMembershipFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OrgMembershipFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldUpdatedBy))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *OrgMembershipFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldRole))
+}
+
+// WhereOrganizationID applies the entql string predicate on the organization_id field.
+func (f *OrgMembershipFilter) WhereOrganizationID(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldOrganizationID))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *OrgMembershipFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldUserID))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *OrgMembershipFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *OrgMembershipFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *OrgMembershipFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *OrgMembershipFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (oq *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
+	oq.predicates = append(oq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OrganizationQuery builder.
+func (oq *OrganizationQuery) Filter() *OrganizationFilter {
+	return &OrganizationFilter{config: oq.config, predicateAdder: oq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OrganizationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OrganizationMutation builder.
+func (m *OrganizationMutation) Filter() *OrganizationFilter {
+	return &OrganizationFilter{config: m.config, predicateAdder: m}
+}
+
+// OrganizationFilter provides a generic filtering capability at runtime for OrganizationQuery.
+type OrganizationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OrganizationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *OrganizationFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(organization.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OrganizationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(organization.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OrganizationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(organization.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *OrganizationFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(organization.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *OrganizationFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(organization.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OrganizationFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(organization.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *OrganizationFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(organization.FieldDeletedBy))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *OrganizationFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(organization.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *OrganizationFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(organization.FieldDescription))
+}
+
+// WhereHasMembers applies a predicate to check if query has an edge members.
+func (f *OrganizationFilter) WhereHasMembers() {
+	f.Where(entql.HasEdge("members"))
+}
+
+// WhereHasMembersWith applies a predicate to check if query has an edge members with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasMembersWith(preds ...predicate.OrgMembership) {
+	f.Where(entql.HasEdgeWith("members", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *OrganizationFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasTodosWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *OrganizationFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TagQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TagQuery builder.
+func (tq *TagQuery) Filter() *TagFilter {
+	return &TagFilter{config: tq.config, predicateAdder: tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TagMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TagMutation builder.
+func (m *TagMutation) Filter() *TagFilter {
+	return &TagFilter{config: m.config, predicateAdder: m}
+}
+
+// TagFilter provides a generic filtering capability at runtime for TagQuery.
+type TagFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TagFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *TagFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(tag.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TagFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(tag.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TagFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(tag.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *TagFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(tag.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *TagFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(tag.FieldUpdatedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *TagFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(tag.FieldOwnerID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TagFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(tag.FieldName))
+}
+
+// WhereColor applies the entql string predicate on the color field.
+func (f *TagFilter) WhereColor(p entql.StringP) {
+	f.Where(p.Field(tag.FieldColor))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *TagFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(tag.FieldDescription))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *TagFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *TagFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *TagFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *TagFilter) WhereHasTodosWith(preds ...predicate.Todo) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (tq *TodoQuery) addPredicate(pred func(s *sql.Selector)) {
 	tq.predicates = append(tq.predicates, pred)
@@ -67,7 +652,7 @@ type TodoFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TodoFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -78,6 +663,41 @@ func (f *TodoFilter) WhereID(p entql.StringP) {
 	f.Where(p.Field(todo.FieldID))
 }
 
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TodoFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TodoFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *TodoFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(todo.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *TodoFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(todo.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TodoFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *TodoFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(todo.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *TodoFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(todo.FieldOwnerID))
+}
+
 // WhereName applies the entql string predicate on the name field.
 func (f *TodoFilter) WhereName(p entql.StringP) {
 	f.Where(p.Field(todo.FieldName))
@@ -87,3 +707,280 @@ func (f *TodoFilter) WhereName(p entql.StringP) {
 func (f *TodoFilter) WhereDescription(p entql.StringP) {
 	f.Where(p.Field(todo.FieldDescription))
 }
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TodoFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(todo.FieldStatus))
+}
+
+// WherePriority applies the entql int predicate on the priority field.
+func (f *TodoFilter) WherePriority(p entql.IntP) {
+	f.Where(p.Field(todo.FieldPriority))
+}
+
+// WhereDueDate applies the entql time.Time predicate on the due_date field.
+func (f *TodoFilter) WhereDueDate(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldDueDate))
+}
+
+// WhereCompletedAt applies the entql time.Time predicate on the completed_at field.
+func (f *TodoFilter) WhereCompletedAt(p entql.TimeP) {
+	f.Where(p.Field(todo.FieldCompletedAt))
+}
+
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TodoFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(todo.FieldVersion))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *TodoFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *TodoFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *TodoFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (thq *TodoHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	thq.predicates = append(thq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TodoHistoryQuery builder.
+func (thq *TodoHistoryQuery) Filter() *TodoHistoryFilter {
+	return &TodoHistoryFilter{config: thq.config, predicateAdder: thq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TodoHistoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TodoHistoryMutation builder.
+func (m *TodoHistoryMutation) Filter() *TodoHistoryFilter {
+	return &TodoHistoryFilter{config: m.config, predicateAdder: m}
+}
+
+// TodoHistoryFilter provides a generic filtering capability at runtime for TodoHistoryQuery.
+type TodoHistoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TodoHistoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *TodoHistoryFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldID))
+}
+
+// WhereHistoryTime applies the entql time.Time predicate on the history_time field.
+func (f *TodoHistoryFilter) WhereHistoryTime(p entql.TimeP) {
+	f.Where(p.Field(todohistory.FieldHistoryTime))
+}
+
+// WhereRef applies the entql string predicate on the ref field.
+func (f *TodoHistoryFilter) WhereRef(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldRef))
+}
+
+// WhereOperation applies the entql string predicate on the operation field.
+func (f *TodoHistoryFilter) WhereOperation(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldOperation))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TodoHistoryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(todohistory.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TodoHistoryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(todohistory.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *TodoHistoryFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *TodoHistoryFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TodoHistoryFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(todohistory.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *TodoHistoryFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *TodoHistoryFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldOwnerID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TodoHistoryFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *TodoHistoryFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldDescription))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TodoHistoryFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(todohistory.FieldStatus))
+}
+
+// WherePriority applies the entql int predicate on the priority field.
+func (f *TodoHistoryFilter) WherePriority(p entql.IntP) {
+	f.Where(p.Field(todohistory.FieldPriority))
+}
+
+// WhereDueDate applies the entql time.Time predicate on the due_date field.
+func (f *TodoHistoryFilter) WhereDueDate(p entql.TimeP) {
+	f.Where(p.Field(todohistory.FieldDueDate))
+}
+
+// WhereCompletedAt applies the entql time.Time predicate on the completed_at field.
+func (f *TodoHistoryFilter) WhereCompletedAt(p entql.TimeP) {
+	f.Where(p.Field(todohistory.FieldCompletedAt))
+}
+
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TodoHistoryFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(todohistory.FieldVersion))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
+	uq.predicates = append(uq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserQuery builder.
+func (uq *UserQuery) Filter() *UserFilter {
+	return &UserFilter{config: uq.config, predicateAdder: uq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
+func (m *UserMutation) Filter() *UserFilter {
+	return &UserFilter{config: m.config, predicateAdder: m}
+}
+
+// UserFilter provides a generic filtering capability at runtime for UserQuery.
+type UserFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *UserFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(user.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *UserFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(user.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *UserFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(user.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *UserFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *UserFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(user.FieldDeletedBy))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *UserFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(user.FieldEmail))
+}
+
+// WhereDisplayName applies the entql string predicate on the display_name field.
+func (f *UserFilter) WhereDisplayName(p entql.StringP) {
+	f.Where(p.Field(user.FieldDisplayName))
+}
+
+// WherePassword applies the entql string predicate on the password field.
+func (f *UserFilter) WherePassword(p entql.StringP) {
+	f.Where(p.Field(user.FieldPassword))
+}
+
+// WhereHasMemberships applies a predicate to check if query has an edge memberships.
+func (f *UserFilter) WhereHasMemberships() {
+	f.Where(entql.HasEdge("memberships"))
+}
+
+// WhereHasMembershipsWith applies a predicate to check if query has an edge memberships with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMembershipsWith(preds ...predicate.OrgMembership) {
+	f.Where(entql.HasEdgeWith("memberships", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
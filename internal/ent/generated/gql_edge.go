@@ -1,3 +1,149 @@
 // Code generated by ent, DO NOT EDIT.
 
 package generated
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (om *OrgMembership) Organization(ctx context.Context) (*Organization, error) {
+	result, err := om.Edges.OrganizationOrErr()
+	if IsNotLoaded(err) {
+		result, err = om.QueryOrganization().Only(ctx)
+	}
+	return result, err
+}
+
+func (om *OrgMembership) User(ctx context.Context) (*User, error) {
+	result, err := om.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = om.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (o *Organization) Members(ctx context.Context) (result []*OrgMembership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = o.NamedMembers(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = o.Edges.MembersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = o.QueryMembers().All(ctx)
+	}
+	return result, err
+}
+
+func (o *Organization) Todos(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput,
+) (*TodoConnection, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		WithTodoFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := o.Edges.totalCount[1][alias]
+	if nodes, err := o.NamedTodos(alias); err == nil || hasTotalCount {
+		pager, err := newTodoPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return o.QueryTodos().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (o *Organization) Tags(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TagOrder, where *TagWhereInput,
+) (*TagConnection, error) {
+	opts := []TagPaginateOption{
+		WithTagOrder(orderBy),
+		WithTagFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := o.Edges.totalCount[2][alias]
+	if nodes, err := o.NamedTags(alias); err == nil || hasTotalCount {
+		pager, err := newTagPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TagConnection{Edges: []*TagEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return o.QueryTags().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (t *Tag) Owner(ctx context.Context) (*Organization, error) {
+	result, err := t.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryOwner().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Tag) Todos(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput,
+) (*TodoConnection, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		WithTodoFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := t.Edges.totalCount[1][alias]
+	if nodes, err := t.NamedTodos(alias); err == nil || hasTotalCount {
+		pager, err := newTodoPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return t.QueryTodos().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (t *Todo) Owner(ctx context.Context) (*Organization, error) {
+	result, err := t.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryOwner().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Todo) Tags(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TagOrder, where *TagWhereInput,
+) (*TagConnection, error) {
+	opts := []TagPaginateOption{
+		WithTagOrder(orderBy),
+		WithTagFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := t.Edges.totalCount[1][alias]
+	if nodes, err := t.NamedTags(alias); err == nil || hasTotalCount {
+		pager, err := newTagPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TagConnection{Edges: []*TagEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return t.QueryTags().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (u *User) Memberships(ctx context.Context) (result []*OrgMembership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedMemberships(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.MembershipsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryMemberships().All(ctx)
+	}
+	return result, err
+}
@@ -2,10 +2,89 @@
 
 package generated
 
+import (
+	"time"
+
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+)
+
+// CreateTagInput represents a mutation input for creating tags.
+type CreateTagInput struct {
+	Name        string
+	Color       *string
+	Description *string
+	OwnerID     *string
+}
+
+// Mutate applies the CreateTagInput on the TagMutation builder.
+func (i *CreateTagInput) Mutate(m *TagMutation) {
+	m.SetName(i.Name)
+	if v := i.Color; v != nil {
+		m.SetColor(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.OwnerID; v != nil {
+		m.SetOwnerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateTagInput on the TagCreate builder.
+func (c *TagCreate) SetInput(i CreateTagInput) *TagCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateTagInput represents a mutation input for updating tags.
+type UpdateTagInput struct {
+	Name             *string
+	ClearColor       bool
+	Color            *string
+	ClearDescription bool
+	Description      *string
+}
+
+// Mutate applies the UpdateTagInput on the TagMutation builder.
+func (i *UpdateTagInput) Mutate(m *TagMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearColor {
+		m.ClearColor()
+	}
+	if v := i.Color; v != nil {
+		m.SetColor(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateTagInput on the TagUpdate builder.
+func (c *TagUpdate) SetInput(i UpdateTagInput) *TagUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateTagInput on the TagUpdateOne builder.
+func (c *TagUpdateOne) SetInput(i UpdateTagInput) *TagUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateTodoInput represents a mutation input for creating todos.
 type CreateTodoInput struct {
 	Name        string
 	Description *string
+	Status      *todo.Status
+	Priority    *int
+	DueDate     *time.Time
+	OwnerID     *string
+	TagIDs      []string
 }
 
 // Mutate applies the CreateTodoInput on the TodoMutation builder.
@@ -14,6 +93,21 @@ func (i *CreateTodoInput) Mutate(m *TodoMutation) {
 	if v := i.Description; v != nil {
 		m.SetDescription(*v)
 	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	if v := i.DueDate; v != nil {
+		m.SetDueDate(*v)
+	}
+	if v := i.OwnerID; v != nil {
+		m.SetOwnerID(*v)
+	}
+	if v := i.TagIDs; len(v) > 0 {
+		m.AddTagIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the CreateTodoInput on the TodoCreate builder.
@@ -27,6 +121,13 @@ type UpdateTodoInput struct {
 	Name             *string
 	ClearDescription bool
 	Description      *string
+	Status           *todo.Status
+	Priority         *int
+	ClearDueDate     bool
+	DueDate          *time.Time
+	ClearTags        bool
+	AddTagIDs        []string
+	RemoveTagIDs     []string
 }
 
 // Mutate applies the UpdateTodoInput on the TodoMutation builder.
@@ -40,6 +141,27 @@ func (i *UpdateTodoInput) Mutate(m *TodoMutation) {
 	if v := i.Description; v != nil {
 		m.SetDescription(*v)
 	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	if i.ClearDueDate {
+		m.ClearDueDate()
+	}
+	if v := i.DueDate; v != nil {
+		m.SetDueDate(*v)
+	}
+	if i.ClearTags {
+		m.ClearTags()
+	}
+	if v := i.AddTagIDs; len(v) > 0 {
+		m.AddTagIDs(v...)
+	}
+	if v := i.RemoveTagIDs; len(v) > 0 {
+		m.RemoveTagIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateTodoInput on the TodoUpdate builder.
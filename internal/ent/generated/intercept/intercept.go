@@ -8,8 +8,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
 	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
 	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
 )
 
 // The Query interface represents an operation that queries a graph.
@@ -68,6 +73,87 @@ func (f TraverseFunc) Traverse(ctx context.Context, q generated.Query) error {
 	return f(ctx, query)
 }
 
+// The OrgMembershipFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrgMembershipFunc func(context.Context, *generated.OrgMembershipQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrgMembershipFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OrgMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OrgMembershipQuery", q)
+}
+
+// The TraverseOrgMembership type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrgMembership func(context.Context, *generated.OrgMembershipQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrgMembership) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrgMembership) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OrgMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OrgMembershipQuery", q)
+}
+
+// The OrganizationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrganizationFunc func(context.Context, *generated.OrganizationQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrganizationFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OrganizationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OrganizationQuery", q)
+}
+
+// The TraverseOrganization type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrganization func(context.Context, *generated.OrganizationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrganization) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrganization) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OrganizationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OrganizationQuery", q)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TagFunc func(context.Context, *generated.TagQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TagFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TagQuery", q)
+}
+
+// The TraverseTag type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTag func(context.Context, *generated.TagQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTag) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTag) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TagQuery", q)
+}
+
 // The TodoFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TodoFunc func(context.Context, *generated.TodoQuery) (generated.Value, error)
 
@@ -95,11 +181,75 @@ func (f TraverseTodo) Traverse(ctx context.Context, q generated.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *generated.TodoQuery", q)
 }
 
+// The TodoHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TodoHistoryFunc func(context.Context, *generated.TodoHistoryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TodoHistoryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TodoHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TodoHistoryQuery", q)
+}
+
+// The TraverseTodoHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTodoHistory func(context.Context, *generated.TodoHistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTodoHistory) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTodoHistory) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TodoHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TodoHistoryQuery", q)
+}
+
+// The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserFunc func(context.Context, *generated.UserQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UserQuery", q)
+}
+
+// The TraverseUser type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUser func(context.Context, *generated.UserQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUser) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUser) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UserQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q generated.Query) (Query, error) {
 	switch q := q.(type) {
+	case *generated.OrgMembershipQuery:
+		return &query[*generated.OrgMembershipQuery, predicate.OrgMembership, orgmembership.OrderOption]{typ: generated.TypeOrgMembership, tq: q}, nil
+	case *generated.OrganizationQuery:
+		return &query[*generated.OrganizationQuery, predicate.Organization, organization.OrderOption]{typ: generated.TypeOrganization, tq: q}, nil
+	case *generated.TagQuery:
+		return &query[*generated.TagQuery, predicate.Tag, tag.OrderOption]{typ: generated.TypeTag, tq: q}, nil
 	case *generated.TodoQuery:
 		return &query[*generated.TodoQuery, predicate.Todo, todo.OrderOption]{typ: generated.TypeTodo, tq: q}, nil
+	case *generated.TodoHistoryQuery:
+		return &query[*generated.TodoHistoryQuery, predicate.TodoHistory, todohistory.OrderOption]{typ: generated.TypeTodoHistory, tq: q}, nil
+	case *generated.UserQuery:
+		return &query[*generated.UserQuery, predicate.User, user.OrderOption]{typ: generated.TypeUser, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
@@ -7,7 +7,13 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	Todo string // Todo table.
+	OrgMembership string // OrgMembership table.
+	Organization  string // Organization table.
+	Tag           string // Tag table.
+	Todo          string // Todo table.
+	TodoTags      string // Todo-tags->Tag table.
+	TodoHistory   string // TodoHistory table.
+	User          string // User table.
 }
 
 type schemaCtxKey struct{}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+)
+
+// OrgMembership is the model entity for the OrgMembership schema.
+type OrgMembership struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// the time the object was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// the time the object was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy string `json:"created_by,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// the role of the user in the organization
+	Role orgmembership.Role `json:"role,omitempty"`
+	// the id of the organization
+	OrganizationID string `json:"organization_id,omitempty"`
+	// the id of the member
+	UserID string `json:"user_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrgMembershipQuery when eager-loading is set.
+	Edges        OrgMembershipEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrgMembershipEdges holds the relations/edges for other nodes in the graph.
+type OrgMembershipEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgMembershipEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgMembershipEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrgMembership) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orgmembership.FieldID, orgmembership.FieldCreatedBy, orgmembership.FieldUpdatedBy, orgmembership.FieldRole, orgmembership.FieldOrganizationID, orgmembership.FieldUserID:
+			values[i] = new(sql.NullString)
+		case orgmembership.FieldCreatedAt, orgmembership.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrgMembership fields.
+func (om *OrgMembership) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orgmembership.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				om.ID = value.String
+			}
+		case orgmembership.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				om.CreatedAt = value.Time
+			}
+		case orgmembership.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				om.UpdatedAt = value.Time
+			}
+		case orgmembership.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				om.CreatedBy = value.String
+			}
+		case orgmembership.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				om.UpdatedBy = value.String
+			}
+		case orgmembership.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				om.Role = orgmembership.Role(value.String)
+			}
+		case orgmembership.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				om.OrganizationID = value.String
+			}
+		case orgmembership.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				om.UserID = value.String
+			}
+		default:
+			om.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrgMembership.
+// This includes values selected through modifiers, order, etc.
+func (om *OrgMembership) Value(name string) (ent.Value, error) {
+	return om.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the OrgMembership entity.
+func (om *OrgMembership) QueryOrganization() *OrganizationQuery {
+	return NewOrgMembershipClient(om.config).QueryOrganization(om)
+}
+
+// QueryUser queries the "user" edge of the OrgMembership entity.
+func (om *OrgMembership) QueryUser() *UserQuery {
+	return NewOrgMembershipClient(om.config).QueryUser(om)
+}
+
+// Update returns a builder for updating this OrgMembership.
+// Note that you need to call OrgMembership.Unwrap() before calling this method if this OrgMembership
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (om *OrgMembership) Update() *OrgMembershipUpdateOne {
+	return NewOrgMembershipClient(om.config).UpdateOne(om)
+}
+
+// Unwrap unwraps the OrgMembership entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (om *OrgMembership) Unwrap() *OrgMembership {
+	_tx, ok := om.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: OrgMembership is not a transactional entity")
+	}
+	om.config.driver = _tx.drv
+	return om
+}
+
+// String implements the fmt.Stringer.
+func (om *OrgMembership) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrgMembership(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", om.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(om.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(om.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(om.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(om.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", om.Role))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(om.OrganizationID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(om.UserID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrgMemberships is a parsable slice of OrgMembership.
+type OrgMemberships []*OrgMembership
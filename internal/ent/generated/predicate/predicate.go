@@ -6,5 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// OrgMembership is the predicate function for orgmembership builders.
+type OrgMembership func(*sql.Selector)
+
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
+
+// TodoHistory is the predicate function for todohistory builders.
+type TodoHistory func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
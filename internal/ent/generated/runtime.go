@@ -2,19 +2,4 @@
 
 package generated
 
-import (
-	"github.com/datumforge/go-template/internal/ent/generated/todo"
-	"github.com/datumforge/go-template/internal/ent/schema"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	todoFields := schema.Todo{}.Fields()
-	_ = todoFields
-	// todoDescName is the schema descriptor for name field.
-	todoDescName := todoFields[1].Descriptor()
-	// todo.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	todo.NameValidator = todoDescName.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in github.com/datumforge/go-template/internal/ent/generated/runtime/runtime.go
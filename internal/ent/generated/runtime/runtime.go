@@ -2,7 +2,269 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/datumforge/go-template/internal/ent/generated/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	orgmembershipMixin := schema.OrgMembership{}.Mixin()
+	orgmembershipMixinHooks1 := orgmembershipMixin[1].Hooks()
+	orgmembershipHooks := schema.OrgMembership{}.Hooks()
+	orgmembership.Hooks[0] = orgmembershipMixinHooks1[0]
+	orgmembership.Hooks[1] = orgmembershipHooks[0]
+	orgmembershipInters := schema.OrgMembership{}.Interceptors()
+	orgmembership.Interceptors[0] = orgmembershipInters[0]
+	orgmembershipMixinFields0 := orgmembershipMixin[0].Fields()
+	_ = orgmembershipMixinFields0
+	orgmembershipMixinFields1 := orgmembershipMixin[1].Fields()
+	_ = orgmembershipMixinFields1
+	orgmembershipFields := schema.OrgMembership{}.Fields()
+	_ = orgmembershipFields
+	// orgmembershipDescCreatedAt is the schema descriptor for created_at field.
+	orgmembershipDescCreatedAt := orgmembershipMixinFields1[0].Descriptor()
+	// orgmembership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orgmembership.DefaultCreatedAt = orgmembershipDescCreatedAt.Default.(func() time.Time)
+	// orgmembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	orgmembershipDescUpdatedAt := orgmembershipMixinFields1[1].Descriptor()
+	// orgmembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	orgmembership.DefaultUpdatedAt = orgmembershipDescUpdatedAt.Default.(func() time.Time)
+	// orgmembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	orgmembership.UpdateDefaultUpdatedAt = orgmembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orgmembershipDescID is the schema descriptor for id field.
+	orgmembershipDescID := orgmembershipMixinFields0[0].Descriptor()
+	// orgmembership.DefaultID holds the default value on creation for the id field.
+	orgmembership.DefaultID = orgmembershipDescID.Default.(func() string)
+	// orgmembership.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	orgmembership.IDValidator = orgmembershipDescID.Validators[0].(func(string) error)
+	organizationMixin := schema.Organization{}.Mixin()
+	organizationMixinHooks1 := organizationMixin[1].Hooks()
+	organizationMixinHooks2 := organizationMixin[2].Hooks()
+	organization.Hooks[0] = organizationMixinHooks1[0]
+	organization.Hooks[1] = organizationMixinHooks2[0]
+	organizationMixinInters2 := organizationMixin[2].Interceptors()
+	organizationInters := schema.Organization{}.Interceptors()
+	organization.Interceptors[0] = organizationMixinInters2[0]
+	organization.Interceptors[1] = organizationInters[0]
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	_ = organizationMixinFields0
+	organizationMixinFields1 := organizationMixin[1].Fields()
+	_ = organizationMixinFields1
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationMixinFields1[0].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationMixinFields1[1].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescID is the schema descriptor for id field.
+	organizationDescID := organizationMixinFields0[0].Descriptor()
+	// organization.DefaultID holds the default value on creation for the id field.
+	organization.DefaultID = organizationDescID.Default.(func() string)
+	// organization.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	organization.IDValidator = organizationDescID.Validators[0].(func(string) error)
+	tagMixin := schema.Tag{}.Mixin()
+	tagMixinHooks1 := tagMixin[1].Hooks()
+	tagMixinHooks2 := tagMixin[2].Hooks()
+	tag.Hooks[0] = tagMixinHooks1[0]
+	tag.Hooks[1] = tagMixinHooks2[0]
+	tagMixinInters2 := tagMixin[2].Interceptors()
+	tag.Interceptors[0] = tagMixinInters2[0]
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagMixinFields1 := tagMixin[1].Fields()
+	_ = tagMixinFields1
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagMixinFields1[0].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagMixinFields1[1].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[1].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagMixinFields0[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() string)
+	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tag.IDValidator = tagDescID.Validators[0].(func(string) error)
+	todoMixin := schema.Todo{}.Mixin()
+	todo.Policy = privacy.NewPolicies(schema.Todo{})
+	todo.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := todo.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	todoMixinHooks1 := todoMixin[1].Hooks()
+	todoMixinHooks2 := todoMixin[2].Hooks()
+	todoMixinHooks3 := todoMixin[3].Hooks()
+	todoHooks := schema.Todo{}.Hooks()
+
+	todo.Hooks[1] = todoMixinHooks1[0]
+
+	todo.Hooks[2] = todoMixinHooks2[0]
+
+	todo.Hooks[3] = todoMixinHooks3[0]
+
+	todo.Hooks[4] = todoHooks[0]
+
+	todo.Hooks[5] = todoHooks[1]
+
+	todo.Hooks[6] = todoHooks[2]
+
+	todo.Hooks[7] = todoHooks[3]
+
+	todo.Hooks[8] = todoHooks[4]
+	todoMixinInters2 := todoMixin[2].Interceptors()
+	todoMixinInters3 := todoMixin[3].Interceptors()
+	todoInters := schema.Todo{}.Interceptors()
+	todo.Interceptors[0] = todoMixinInters2[0]
+	todo.Interceptors[1] = todoMixinInters3[0]
+	todo.Interceptors[2] = todoInters[0]
+	todoMixinFields0 := todoMixin[0].Fields()
+	_ = todoMixinFields0
+	todoMixinFields1 := todoMixin[1].Fields()
+	_ = todoMixinFields1
+	todoFields := schema.Todo{}.Fields()
+	_ = todoFields
+	// todoDescCreatedAt is the schema descriptor for created_at field.
+	todoDescCreatedAt := todoMixinFields1[0].Descriptor()
+	// todo.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todo.DefaultCreatedAt = todoDescCreatedAt.Default.(func() time.Time)
+	// todoDescUpdatedAt is the schema descriptor for updated_at field.
+	todoDescUpdatedAt := todoMixinFields1[1].Descriptor()
+	// todo.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todo.DefaultUpdatedAt = todoDescUpdatedAt.Default.(func() time.Time)
+	// todo.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todo.UpdateDefaultUpdatedAt = todoDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todoDescName is the schema descriptor for name field.
+	todoDescName := todoFields[0].Descriptor()
+	// todo.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	todo.NameValidator = todoDescName.Validators[0].(func(string) error)
+	// todoDescPriority is the schema descriptor for priority field.
+	todoDescPriority := todoFields[3].Descriptor()
+	// todo.DefaultPriority holds the default value on creation for the priority field.
+	todo.DefaultPriority = todoDescPriority.Default.(int)
+	// todo.PriorityValidator is a validator for the "priority" field. It is called by the builders before save.
+	todo.PriorityValidator = todoDescPriority.Validators[0].(func(int) error)
+	// todoDescVersion is the schema descriptor for version field.
+	todoDescVersion := todoFields[6].Descriptor()
+	// todo.DefaultVersion holds the default value on creation for the version field.
+	todo.DefaultVersion = todoDescVersion.Default.(int)
+	// todo.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	todo.VersionValidator = todoDescVersion.Validators[0].(func(int) error)
+	// todoDescID is the schema descriptor for id field.
+	todoDescID := todoMixinFields0[0].Descriptor()
+	// todo.DefaultID holds the default value on creation for the id field.
+	todo.DefaultID = todoDescID.Default.(func() string)
+	// todo.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todo.IDValidator = todoDescID.Validators[0].(func(string) error)
+	todohistoryInters := schema.TodoHistory{}.Interceptors()
+	todohistory.Interceptors[0] = todohistoryInters[0]
+	todohistoryFields := schema.TodoHistory{}.Fields()
+	_ = todohistoryFields
+	// todohistoryDescHistoryTime is the schema descriptor for history_time field.
+	todohistoryDescHistoryTime := todohistoryFields[0].Descriptor()
+	// todohistory.DefaultHistoryTime holds the default value on creation for the history_time field.
+	todohistory.DefaultHistoryTime = todohistoryDescHistoryTime.Default.(func() time.Time)
+	// todohistoryDescCreatedAt is the schema descriptor for created_at field.
+	todohistoryDescCreatedAt := todohistoryFields[4].Descriptor()
+	// todohistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todohistory.DefaultCreatedAt = todohistoryDescCreatedAt.Default.(func() time.Time)
+	// todohistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	todohistoryDescUpdatedAt := todohistoryFields[5].Descriptor()
+	// todohistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todohistory.DefaultUpdatedAt = todohistoryDescUpdatedAt.Default.(func() time.Time)
+	// todohistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todohistory.UpdateDefaultUpdatedAt = todohistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todohistoryDescPriority is the schema descriptor for priority field.
+	todohistoryDescPriority := todohistoryFields[14].Descriptor()
+	// todohistory.DefaultPriority holds the default value on creation for the priority field.
+	todohistory.DefaultPriority = todohistoryDescPriority.Default.(int)
+	// todohistoryDescVersion is the schema descriptor for version field.
+	todohistoryDescVersion := todohistoryFields[17].Descriptor()
+	// todohistory.DefaultVersion holds the default value on creation for the version field.
+	todohistory.DefaultVersion = todohistoryDescVersion.Default.(int)
+	// todohistoryDescID is the schema descriptor for id field.
+	todohistoryDescID := todohistoryFields[3].Descriptor()
+	// todohistory.DefaultID holds the default value on creation for the id field.
+	todohistory.DefaultID = todohistoryDescID.Default.(func() string)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks1 := userMixin[1].Hooks()
+	userMixinHooks2 := userMixin[2].Hooks()
+	userHooks := schema.User{}.Hooks()
+	user.Hooks[0] = userMixinHooks1[0]
+	user.Hooks[1] = userMixinHooks2[0]
+	user.Hooks[2] = userHooks[0]
+	userMixinInters2 := userMixin[2].Interceptors()
+	userInters := schema.User{}.Interceptors()
+	user.Interceptors[0] = userMixinInters2[0]
+	user.Interceptors[1] = userInters[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userMixinFields1 := userMixin[1].Fields()
+	_ = userMixinFields1
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields1[0].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields1[1].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[0].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userMixinFields0[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() string)
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.0"                                         // Version of ent codegen.
@@ -6,11 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
 	"github.com/datumforge/go-template/internal/ent/generated/todo"
 
 	"github.com/datumforge/go-template/internal/ent/generated/internal"
@@ -19,8 +21,9 @@ import (
 // TodoUpdate is the builder for updating Todo entities.
 type TodoUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TodoMutation
+	hooks     []Hook
+	mutation  *TodoMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TodoUpdate builder.
@@ -29,6 +32,78 @@ func (tu *TodoUpdate) Where(ps ...predicate.Todo) *TodoUpdate {
 	return tu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (tu *TodoUpdate) SetUpdatedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetUpdatedAt(t)
+	return tu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (tu *TodoUpdate) ClearUpdatedAt() *TodoUpdate {
+	tu.mutation.ClearUpdatedAt()
+	return tu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tu *TodoUpdate) SetUpdatedBy(s string) *TodoUpdate {
+	tu.mutation.SetUpdatedBy(s)
+	return tu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableUpdatedBy(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetUpdatedBy(*s)
+	}
+	return tu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tu *TodoUpdate) ClearUpdatedBy() *TodoUpdate {
+	tu.mutation.ClearUpdatedBy()
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TodoUpdate) SetDeletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDeletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tu *TodoUpdate) SetDeletedBy(s string) *TodoUpdate {
+	tu.mutation.SetDeletedBy(s)
+	return tu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDeletedBy(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetDeletedBy(*s)
+	}
+	return tu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (tu *TodoUpdate) ClearDeletedBy() *TodoUpdate {
+	tu.mutation.ClearDeletedBy()
+	return tu
+}
+
 // SetName sets the "name" field.
 func (tu *TodoUpdate) SetName(s string) *TodoUpdate {
 	tu.mutation.SetName(s)
@@ -63,13 +138,148 @@ func (tu *TodoUpdate) ClearDescription() *TodoUpdate {
 	return tu
 }
 
+// SetStatus sets the "status" field.
+func (tu *TodoUpdate) SetStatus(t todo.Status) *TodoUpdate {
+	tu.mutation.SetStatus(t)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableStatus(t *todo.Status) *TodoUpdate {
+	if t != nil {
+		tu.SetStatus(*t)
+	}
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TodoUpdate) SetPriority(i int) *TodoUpdate {
+	tu.mutation.ResetPriority()
+	tu.mutation.SetPriority(i)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillablePriority(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetPriority(*i)
+	}
+	return tu
+}
+
+// AddPriority adds i to the "priority" field.
+func (tu *TodoUpdate) AddPriority(i int) *TodoUpdate {
+	tu.mutation.AddPriority(i)
+	return tu
+}
+
+// SetDueDate sets the "due_date" field.
+func (tu *TodoUpdate) SetDueDate(t time.Time) *TodoUpdate {
+	tu.mutation.SetDueDate(t)
+	return tu
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDueDate(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDueDate(*t)
+	}
+	return tu
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (tu *TodoUpdate) ClearDueDate() *TodoUpdate {
+	tu.mutation.ClearDueDate()
+	return tu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tu *TodoUpdate) SetCompletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetCompletedAt(t)
+	return tu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableCompletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetCompletedAt(*t)
+	}
+	return tu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tu *TodoUpdate) ClearCompletedAt() *TodoUpdate {
+	tu.mutation.ClearCompletedAt()
+	return tu
+}
+
+// SetVersion sets the "version" field.
+func (tu *TodoUpdate) SetVersion(i int) *TodoUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableVersion(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TodoUpdate) AddVersion(i int) *TodoUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tu *TodoUpdate) AddTagIDs(ids ...string) *TodoUpdate {
+	tu.mutation.AddTagIDs(ids...)
+	return tu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tu *TodoUpdate) AddTags(t ...*Tag) *TodoUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTagIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (tu *TodoUpdate) Mutation() *TodoMutation {
 	return tu.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tu *TodoUpdate) ClearTags() *TodoUpdate {
+	tu.mutation.ClearTags()
+	return tu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tu *TodoUpdate) RemoveTagIDs(ids ...string) *TodoUpdate {
+	tu.mutation.RemoveTagIDs(ids...)
+	return tu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tu *TodoUpdate) RemoveTags(t ...*Tag) *TodoUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TodoUpdate) Save(ctx context.Context) (int, error) {
+	if err := tu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
 }
 
@@ -95,6 +305,18 @@ func (tu *TodoUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tu *TodoUpdate) defaults() error {
+	if _, ok := tu.mutation.UpdatedAt(); !ok && !tu.mutation.UpdatedAtCleared() {
+		if todo.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized todo.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := todo.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tu *TodoUpdate) check() error {
 	if v, ok := tu.mutation.Name(); ok {
@@ -102,9 +324,30 @@ func (tu *TodoUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "Todo.name": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.Status(); ok {
+		if err := todo.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Todo.status": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`generated: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Version(); ok {
+		if err := todo.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`generated: validator failed for field "Todo.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TodoUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TodoUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
 func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := tu.check(); err != nil {
 		return n, err
@@ -117,6 +360,36 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if tu.mutation.CreatedAtCleared() {
+		_spec.ClearField(todo.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(todo.FieldUpdatedAt, field.TypeTime)
+	}
+	if tu.mutation.CreatedByCleared() {
+		_spec.ClearField(todo.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := tu.mutation.UpdatedBy(); ok {
+		_spec.SetField(todo.FieldUpdatedBy, field.TypeString, value)
+	}
+	if tu.mutation.UpdatedByCleared() {
+		_spec.ClearField(todo.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.DeletedBy(); ok {
+		_spec.SetField(todo.FieldDeletedBy, field.TypeString, value)
+	}
+	if tu.mutation.DeletedByCleared() {
+		_spec.ClearField(todo.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := tu.mutation.Name(); ok {
 		_spec.SetField(todo.FieldName, field.TypeString, value)
 	}
@@ -126,8 +399,84 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.DescriptionCleared() {
 		_spec.ClearField(todo.FieldDescription, field.TypeString)
 	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(todo.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedPriority(); ok {
+		_spec.AddField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.DueDate(); ok {
+		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
+	}
+	if tu.mutation.DueDateCleared() {
+		_spec.ClearField(todo.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tu.mutation.CompletedAt(); ok {
+		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.CompletedAtCleared() {
+		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TodoTags
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TodoTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TodoTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = tu.schemaConfig.Todo
 	ctx = internal.NewSchemaConfigContext(ctx, tu.schemaConfig)
+	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{todo.Label}
@@ -143,9 +492,82 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TodoUpdateOne is the builder for updating a single Todo entity.
 type TodoUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TodoMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TodoMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TodoUpdateOne) SetUpdatedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (tuo *TodoUpdateOne) ClearUpdatedAt() *TodoUpdateOne {
+	tuo.mutation.ClearUpdatedAt()
+	return tuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tuo *TodoUpdateOne) SetUpdatedBy(s string) *TodoUpdateOne {
+	tuo.mutation.SetUpdatedBy(s)
+	return tuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableUpdatedBy(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetUpdatedBy(*s)
+	}
+	return tuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tuo *TodoUpdateOne) ClearUpdatedBy() *TodoUpdateOne {
+	tuo.mutation.ClearUpdatedBy()
+	return tuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TodoUpdateOne) SetDeletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tuo *TodoUpdateOne) SetDeletedBy(s string) *TodoUpdateOne {
+	tuo.mutation.SetDeletedBy(s)
+	return tuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDeletedBy(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetDeletedBy(*s)
+	}
+	return tuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (tuo *TodoUpdateOne) ClearDeletedBy() *TodoUpdateOne {
+	tuo.mutation.ClearDeletedBy()
+	return tuo
 }
 
 // SetName sets the "name" field.
@@ -182,11 +604,143 @@ func (tuo *TodoUpdateOne) ClearDescription() *TodoUpdateOne {
 	return tuo
 }
 
+// SetStatus sets the "status" field.
+func (tuo *TodoUpdateOne) SetStatus(t todo.Status) *TodoUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableStatus(t *todo.Status) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetStatus(*t)
+	}
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TodoUpdateOne) SetPriority(i int) *TodoUpdateOne {
+	tuo.mutation.ResetPriority()
+	tuo.mutation.SetPriority(i)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillablePriority(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetPriority(*i)
+	}
+	return tuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (tuo *TodoUpdateOne) AddPriority(i int) *TodoUpdateOne {
+	tuo.mutation.AddPriority(i)
+	return tuo
+}
+
+// SetDueDate sets the "due_date" field.
+func (tuo *TodoUpdateOne) SetDueDate(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDueDate(t)
+	return tuo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDueDate(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDueDate(*t)
+	}
+	return tuo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (tuo *TodoUpdateOne) ClearDueDate() *TodoUpdateOne {
+	tuo.mutation.ClearDueDate()
+	return tuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TodoUpdateOne) SetCompletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableCompletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TodoUpdateOne) ClearCompletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetVersion sets the "version" field.
+func (tuo *TodoUpdateOne) SetVersion(i int) *TodoUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableVersion(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
+	}
+	return tuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tuo *TodoUpdateOne) AddVersion(i int) *TodoUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tuo *TodoUpdateOne) AddTagIDs(ids ...string) *TodoUpdateOne {
+	tuo.mutation.AddTagIDs(ids...)
+	return tuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tuo *TodoUpdateOne) AddTags(t ...*Tag) *TodoUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (tuo *TodoUpdateOne) Mutation() *TodoMutation {
 	return tuo.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tuo *TodoUpdateOne) ClearTags() *TodoUpdateOne {
+	tuo.mutation.ClearTags()
+	return tuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tuo *TodoUpdateOne) RemoveTagIDs(ids ...string) *TodoUpdateOne {
+	tuo.mutation.RemoveTagIDs(ids...)
+	return tuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tuo *TodoUpdateOne) RemoveTags(t ...*Tag) *TodoUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the TodoUpdate builder.
 func (tuo *TodoUpdateOne) Where(ps ...predicate.Todo) *TodoUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -202,6 +756,9 @@ func (tuo *TodoUpdateOne) Select(field string, fields ...string) *TodoUpdateOne
 
 // Save executes the query and returns the updated Todo entity.
 func (tuo *TodoUpdateOne) Save(ctx context.Context) (*Todo, error) {
+	if err := tuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
 }
 
@@ -227,6 +784,18 @@ func (tuo *TodoUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tuo *TodoUpdateOne) defaults() error {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok && !tuo.mutation.UpdatedAtCleared() {
+		if todo.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized todo.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := todo.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tuo *TodoUpdateOne) check() error {
 	if v, ok := tuo.mutation.Name(); ok {
@@ -234,9 +803,30 @@ func (tuo *TodoUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "Todo.name": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := todo.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Todo.status": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`generated: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Version(); ok {
+		if err := todo.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`generated: validator failed for field "Todo.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TodoUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TodoUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
 func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 	if err := tuo.check(); err != nil {
 		return _node, err
@@ -266,6 +856,36 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 			}
 		}
 	}
+	if tuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(todo.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(todo.FieldUpdatedAt, field.TypeTime)
+	}
+	if tuo.mutation.CreatedByCleared() {
+		_spec.ClearField(todo.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := tuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(todo.FieldUpdatedBy, field.TypeString, value)
+	}
+	if tuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(todo.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.DeletedBy(); ok {
+		_spec.SetField(todo.FieldDeletedBy, field.TypeString, value)
+	}
+	if tuo.mutation.DeletedByCleared() {
+		_spec.ClearField(todo.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := tuo.mutation.Name(); ok {
 		_spec.SetField(todo.FieldName, field.TypeString, value)
 	}
@@ -275,8 +895,84 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 	if tuo.mutation.DescriptionCleared() {
 		_spec.ClearField(todo.FieldDescription, field.TypeString)
 	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(todo.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedPriority(); ok {
+		_spec.AddField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.DueDate(); ok {
+		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
+	}
+	if tuo.mutation.DueDateCleared() {
+		_spec.ClearField(todo.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TodoTags
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TodoTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TodoTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = tuo.schemaConfig.Todo
 	ctx = internal.NewSchemaConfigContext(ctx, tuo.schemaConfig)
+	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Todo{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
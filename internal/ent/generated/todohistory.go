@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+)
+
+// TodoHistory is the model entity for the TodoHistory schema.
+type TodoHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// HistoryTime holds the value of the "history_time" field.
+	HistoryTime time.Time `json:"history_time,omitempty"`
+	// Ref holds the value of the "ref" field.
+	Ref string `json:"ref,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation enthistory.OpType `json:"operation,omitempty"`
+	// the time the object was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// the time the object was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy string `json:"created_by,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// the time the object was deleted
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// the user or system actor that deleted the object
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// the id of the organization that owns the object
+	OwnerID string `json:"owner_id,omitempty"`
+	// the name of the todo
+	Name string `json:"name,omitempty"`
+	// an optional description of the todo
+	Description string `json:"description,omitempty"`
+	// the workflow status of the todo, transitions are enforced by the status hook
+	Status todohistory.Status `json:"status,omitempty"`
+	// the priority of the todo, higher values are more urgent
+	Priority int `json:"priority,omitempty"`
+	// the optional time the todo is due
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// the time the todo was completed, set when the status changes to DONE
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// the version of the todo, incremented on every update and used to detect concurrent changes
+	Version      int `json:"version,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todohistory.FieldOperation:
+			values[i] = new(enthistory.OpType)
+		case todohistory.FieldPriority, todohistory.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case todohistory.FieldID, todohistory.FieldRef, todohistory.FieldCreatedBy, todohistory.FieldUpdatedBy, todohistory.FieldDeletedBy, todohistory.FieldOwnerID, todohistory.FieldName, todohistory.FieldDescription, todohistory.FieldStatus:
+			values[i] = new(sql.NullString)
+		case todohistory.FieldHistoryTime, todohistory.FieldCreatedAt, todohistory.FieldUpdatedAt, todohistory.FieldDeletedAt, todohistory.FieldDueDate, todohistory.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoHistory fields.
+func (th *TodoHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todohistory.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				th.ID = value.String
+			}
+		case todohistory.FieldHistoryTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field history_time", values[i])
+			} else if value.Valid {
+				th.HistoryTime = value.Time
+			}
+		case todohistory.FieldRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ref", values[i])
+			} else if value.Valid {
+				th.Ref = value.String
+			}
+		case todohistory.FieldOperation:
+			if value, ok := values[i].(*enthistory.OpType); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value != nil {
+				th.Operation = *value
+			}
+		case todohistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				th.CreatedAt = value.Time
+			}
+		case todohistory.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				th.UpdatedAt = value.Time
+			}
+		case todohistory.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				th.CreatedBy = value.String
+			}
+		case todohistory.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				th.UpdatedBy = value.String
+			}
+		case todohistory.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				th.DeletedAt = value.Time
+			}
+		case todohistory.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				th.DeletedBy = value.String
+			}
+		case todohistory.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				th.OwnerID = value.String
+			}
+		case todohistory.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				th.Name = value.String
+			}
+		case todohistory.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				th.Description = value.String
+			}
+		case todohistory.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				th.Status = todohistory.Status(value.String)
+			}
+		case todohistory.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				th.Priority = int(value.Int64)
+			}
+		case todohistory.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				th.DueDate = new(time.Time)
+				*th.DueDate = value.Time
+			}
+		case todohistory.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				th.CompletedAt = new(time.Time)
+				*th.CompletedAt = value.Time
+			}
+		case todohistory.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				th.Version = int(value.Int64)
+			}
+		default:
+			th.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoHistory.
+// This includes values selected through modifiers, order, etc.
+func (th *TodoHistory) Value(name string) (ent.Value, error) {
+	return th.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TodoHistory.
+// Note that you need to call TodoHistory.Unwrap() before calling this method if this TodoHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (th *TodoHistory) Update() *TodoHistoryUpdateOne {
+	return NewTodoHistoryClient(th.config).UpdateOne(th)
+}
+
+// Unwrap unwraps the TodoHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (th *TodoHistory) Unwrap() *TodoHistory {
+	_tx, ok := th.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TodoHistory is not a transactional entity")
+	}
+	th.config.driver = _tx.drv
+	return th
+}
+
+// String implements the fmt.Stringer.
+func (th *TodoHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", th.ID))
+	builder.WriteString("history_time=")
+	builder.WriteString(th.HistoryTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ref=")
+	builder.WriteString(th.Ref)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", th.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(th.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(th.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(th.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(th.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(th.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(th.DeletedBy)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(th.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(th.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(th.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", th.Status))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", th.Priority))
+	builder.WriteString(", ")
+	if v := th.DueDate; v != nil {
+		builder.WriteString("due_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := th.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", th.Version))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoHistories is a parsable slice of TodoHistory.
+type TodoHistories []*TodoHistory
@@ -0,0 +1,544 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+)
+
+// TodoHistoryCreate is the builder for creating a TodoHistory entity.
+type TodoHistoryCreate struct {
+	config
+	mutation *TodoHistoryMutation
+	hooks    []Hook
+}
+
+// SetHistoryTime sets the "history_time" field.
+func (thc *TodoHistoryCreate) SetHistoryTime(t time.Time) *TodoHistoryCreate {
+	thc.mutation.SetHistoryTime(t)
+	return thc
+}
+
+// SetNillableHistoryTime sets the "history_time" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableHistoryTime(t *time.Time) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetHistoryTime(*t)
+	}
+	return thc
+}
+
+// SetRef sets the "ref" field.
+func (thc *TodoHistoryCreate) SetRef(s string) *TodoHistoryCreate {
+	thc.mutation.SetRef(s)
+	return thc
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableRef(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetRef(*s)
+	}
+	return thc
+}
+
+// SetOperation sets the "operation" field.
+func (thc *TodoHistoryCreate) SetOperation(et enthistory.OpType) *TodoHistoryCreate {
+	thc.mutation.SetOperation(et)
+	return thc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (thc *TodoHistoryCreate) SetCreatedAt(t time.Time) *TodoHistoryCreate {
+	thc.mutation.SetCreatedAt(t)
+	return thc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableCreatedAt(t *time.Time) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetCreatedAt(*t)
+	}
+	return thc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (thc *TodoHistoryCreate) SetUpdatedAt(t time.Time) *TodoHistoryCreate {
+	thc.mutation.SetUpdatedAt(t)
+	return thc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableUpdatedAt(t *time.Time) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetUpdatedAt(*t)
+	}
+	return thc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (thc *TodoHistoryCreate) SetCreatedBy(s string) *TodoHistoryCreate {
+	thc.mutation.SetCreatedBy(s)
+	return thc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableCreatedBy(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetCreatedBy(*s)
+	}
+	return thc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (thc *TodoHistoryCreate) SetUpdatedBy(s string) *TodoHistoryCreate {
+	thc.mutation.SetUpdatedBy(s)
+	return thc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableUpdatedBy(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetUpdatedBy(*s)
+	}
+	return thc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (thc *TodoHistoryCreate) SetDeletedAt(t time.Time) *TodoHistoryCreate {
+	thc.mutation.SetDeletedAt(t)
+	return thc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableDeletedAt(t *time.Time) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetDeletedAt(*t)
+	}
+	return thc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (thc *TodoHistoryCreate) SetDeletedBy(s string) *TodoHistoryCreate {
+	thc.mutation.SetDeletedBy(s)
+	return thc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableDeletedBy(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetDeletedBy(*s)
+	}
+	return thc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (thc *TodoHistoryCreate) SetOwnerID(s string) *TodoHistoryCreate {
+	thc.mutation.SetOwnerID(s)
+	return thc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableOwnerID(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetOwnerID(*s)
+	}
+	return thc
+}
+
+// SetName sets the "name" field.
+func (thc *TodoHistoryCreate) SetName(s string) *TodoHistoryCreate {
+	thc.mutation.SetName(s)
+	return thc
+}
+
+// SetDescription sets the "description" field.
+func (thc *TodoHistoryCreate) SetDescription(s string) *TodoHistoryCreate {
+	thc.mutation.SetDescription(s)
+	return thc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableDescription(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetDescription(*s)
+	}
+	return thc
+}
+
+// SetStatus sets the "status" field.
+func (thc *TodoHistoryCreate) SetStatus(t todohistory.Status) *TodoHistoryCreate {
+	thc.mutation.SetStatus(t)
+	return thc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableStatus(t *todohistory.Status) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetStatus(*t)
+	}
+	return thc
+}
+
+// SetPriority sets the "priority" field.
+func (thc *TodoHistoryCreate) SetPriority(i int) *TodoHistoryCreate {
+	thc.mutation.SetPriority(i)
+	return thc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillablePriority(i *int) *TodoHistoryCreate {
+	if i != nil {
+		thc.SetPriority(*i)
+	}
+	return thc
+}
+
+// SetDueDate sets the "due_date" field.
+func (thc *TodoHistoryCreate) SetDueDate(t time.Time) *TodoHistoryCreate {
+	thc.mutation.SetDueDate(t)
+	return thc
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableDueDate(t *time.Time) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetDueDate(*t)
+	}
+	return thc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (thc *TodoHistoryCreate) SetCompletedAt(t time.Time) *TodoHistoryCreate {
+	thc.mutation.SetCompletedAt(t)
+	return thc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableCompletedAt(t *time.Time) *TodoHistoryCreate {
+	if t != nil {
+		thc.SetCompletedAt(*t)
+	}
+	return thc
+}
+
+// SetVersion sets the "version" field.
+func (thc *TodoHistoryCreate) SetVersion(i int) *TodoHistoryCreate {
+	thc.mutation.SetVersion(i)
+	return thc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableVersion(i *int) *TodoHistoryCreate {
+	if i != nil {
+		thc.SetVersion(*i)
+	}
+	return thc
+}
+
+// SetID sets the "id" field.
+func (thc *TodoHistoryCreate) SetID(s string) *TodoHistoryCreate {
+	thc.mutation.SetID(s)
+	return thc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (thc *TodoHistoryCreate) SetNillableID(s *string) *TodoHistoryCreate {
+	if s != nil {
+		thc.SetID(*s)
+	}
+	return thc
+}
+
+// Mutation returns the TodoHistoryMutation object of the builder.
+func (thc *TodoHistoryCreate) Mutation() *TodoHistoryMutation {
+	return thc.mutation
+}
+
+// Save creates the TodoHistory in the database.
+func (thc *TodoHistoryCreate) Save(ctx context.Context) (*TodoHistory, error) {
+	thc.defaults()
+	return withHooks(ctx, thc.sqlSave, thc.mutation, thc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (thc *TodoHistoryCreate) SaveX(ctx context.Context) *TodoHistory {
+	v, err := thc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (thc *TodoHistoryCreate) Exec(ctx context.Context) error {
+	_, err := thc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thc *TodoHistoryCreate) ExecX(ctx context.Context) {
+	if err := thc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (thc *TodoHistoryCreate) defaults() {
+	if _, ok := thc.mutation.HistoryTime(); !ok {
+		v := todohistory.DefaultHistoryTime()
+		thc.mutation.SetHistoryTime(v)
+	}
+	if _, ok := thc.mutation.CreatedAt(); !ok {
+		v := todohistory.DefaultCreatedAt()
+		thc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := thc.mutation.UpdatedAt(); !ok {
+		v := todohistory.DefaultUpdatedAt()
+		thc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := thc.mutation.Status(); !ok {
+		v := todohistory.DefaultStatus
+		thc.mutation.SetStatus(v)
+	}
+	if _, ok := thc.mutation.Priority(); !ok {
+		v := todohistory.DefaultPriority
+		thc.mutation.SetPriority(v)
+	}
+	if _, ok := thc.mutation.Version(); !ok {
+		v := todohistory.DefaultVersion
+		thc.mutation.SetVersion(v)
+	}
+	if _, ok := thc.mutation.ID(); !ok {
+		v := todohistory.DefaultID()
+		thc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thc *TodoHistoryCreate) check() error {
+	if _, ok := thc.mutation.HistoryTime(); !ok {
+		return &ValidationError{Name: "history_time", err: errors.New(`generated: missing required field "TodoHistory.history_time"`)}
+	}
+	if _, ok := thc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`generated: missing required field "TodoHistory.operation"`)}
+	}
+	if v, ok := thc.mutation.Operation(); ok {
+		if err := todohistory.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`generated: validator failed for field "TodoHistory.operation": %w`, err)}
+		}
+	}
+	if _, ok := thc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "TodoHistory.name"`)}
+	}
+	if _, ok := thc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "TodoHistory.status"`)}
+	}
+	if v, ok := thc.mutation.Status(); ok {
+		if err := todohistory.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "TodoHistory.status": %w`, err)}
+		}
+	}
+	if _, ok := thc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`generated: missing required field "TodoHistory.priority"`)}
+	}
+	if _, ok := thc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "TodoHistory.version"`)}
+	}
+	return nil
+}
+
+func (thc *TodoHistoryCreate) sqlSave(ctx context.Context) (*TodoHistory, error) {
+	if err := thc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := thc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, thc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TodoHistory.ID type: %T", _spec.ID.Value)
+		}
+	}
+	thc.mutation.id = &_node.ID
+	thc.mutation.done = true
+	return _node, nil
+}
+
+func (thc *TodoHistoryCreate) createSpec() (*TodoHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoHistory{config: thc.config}
+		_spec = sqlgraph.NewCreateSpec(todohistory.Table, sqlgraph.NewFieldSpec(todohistory.FieldID, field.TypeString))
+	)
+	_spec.Schema = thc.schemaConfig.TodoHistory
+	if id, ok := thc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := thc.mutation.HistoryTime(); ok {
+		_spec.SetField(todohistory.FieldHistoryTime, field.TypeTime, value)
+		_node.HistoryTime = value
+	}
+	if value, ok := thc.mutation.Ref(); ok {
+		_spec.SetField(todohistory.FieldRef, field.TypeString, value)
+		_node.Ref = value
+	}
+	if value, ok := thc.mutation.Operation(); ok {
+		_spec.SetField(todohistory.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := thc.mutation.CreatedAt(); ok {
+		_spec.SetField(todohistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := thc.mutation.UpdatedAt(); ok {
+		_spec.SetField(todohistory.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := thc.mutation.CreatedBy(); ok {
+		_spec.SetField(todohistory.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := thc.mutation.UpdatedBy(); ok {
+		_spec.SetField(todohistory.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := thc.mutation.DeletedAt(); ok {
+		_spec.SetField(todohistory.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := thc.mutation.DeletedBy(); ok {
+		_spec.SetField(todohistory.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := thc.mutation.OwnerID(); ok {
+		_spec.SetField(todohistory.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := thc.mutation.Name(); ok {
+		_spec.SetField(todohistory.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := thc.mutation.Description(); ok {
+		_spec.SetField(todohistory.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := thc.mutation.Status(); ok {
+		_spec.SetField(todohistory.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := thc.mutation.Priority(); ok {
+		_spec.SetField(todohistory.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := thc.mutation.DueDate(); ok {
+		_spec.SetField(todohistory.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = &value
+	}
+	if value, ok := thc.mutation.CompletedAt(); ok {
+		_spec.SetField(todohistory.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := thc.mutation.Version(); ok {
+		_spec.SetField(todohistory.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	return _node, _spec
+}
+
+// TodoHistoryCreateBulk is the builder for creating many TodoHistory entities in bulk.
+type TodoHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*TodoHistoryCreate
+}
+
+// Save creates the TodoHistory entities in the database.
+func (thcb *TodoHistoryCreateBulk) Save(ctx context.Context) ([]*TodoHistory, error) {
+	if thcb.err != nil {
+		return nil, thcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(thcb.builders))
+	nodes := make([]*TodoHistory, len(thcb.builders))
+	mutators := make([]Mutator, len(thcb.builders))
+	for i := range thcb.builders {
+		func(i int, root context.Context) {
+			builder := thcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, thcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, thcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, thcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thcb *TodoHistoryCreateBulk) SaveX(ctx context.Context) []*TodoHistory {
+	v, err := thcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (thcb *TodoHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := thcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thcb *TodoHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := thcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
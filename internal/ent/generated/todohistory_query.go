@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+
+	"github.com/datumforge/go-template/internal/ent/generated/internal"
+)
+
+// TodoHistoryQuery is the builder for querying TodoHistory entities.
+type TodoHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []todohistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TodoHistory
+	loadTotal  []func(context.Context, []*TodoHistory) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TodoHistoryQuery builder.
+func (thq *TodoHistoryQuery) Where(ps ...predicate.TodoHistory) *TodoHistoryQuery {
+	thq.predicates = append(thq.predicates, ps...)
+	return thq
+}
+
+// Limit the number of records to be returned by this query.
+func (thq *TodoHistoryQuery) Limit(limit int) *TodoHistoryQuery {
+	thq.ctx.Limit = &limit
+	return thq
+}
+
+// Offset to start from.
+func (thq *TodoHistoryQuery) Offset(offset int) *TodoHistoryQuery {
+	thq.ctx.Offset = &offset
+	return thq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (thq *TodoHistoryQuery) Unique(unique bool) *TodoHistoryQuery {
+	thq.ctx.Unique = &unique
+	return thq
+}
+
+// Order specifies how the records should be ordered.
+func (thq *TodoHistoryQuery) Order(o ...todohistory.OrderOption) *TodoHistoryQuery {
+	thq.order = append(thq.order, o...)
+	return thq
+}
+
+// First returns the first TodoHistory entity from the query.
+// Returns a *NotFoundError when no TodoHistory was found.
+func (thq *TodoHistoryQuery) First(ctx context.Context) (*TodoHistory, error) {
+	nodes, err := thq.Limit(1).All(setContextOp(ctx, thq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{todohistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (thq *TodoHistoryQuery) FirstX(ctx context.Context) *TodoHistory {
+	node, err := thq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TodoHistory ID from the query.
+// Returns a *NotFoundError when no TodoHistory ID was found.
+func (thq *TodoHistoryQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = thq.Limit(1).IDs(setContextOp(ctx, thq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{todohistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (thq *TodoHistoryQuery) FirstIDX(ctx context.Context) string {
+	id, err := thq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TodoHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TodoHistory entity is found.
+// Returns a *NotFoundError when no TodoHistory entities are found.
+func (thq *TodoHistoryQuery) Only(ctx context.Context) (*TodoHistory, error) {
+	nodes, err := thq.Limit(2).All(setContextOp(ctx, thq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{todohistory.Label}
+	default:
+		return nil, &NotSingularError{todohistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (thq *TodoHistoryQuery) OnlyX(ctx context.Context) *TodoHistory {
+	node, err := thq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TodoHistory ID in the query.
+// Returns a *NotSingularError when more than one TodoHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (thq *TodoHistoryQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = thq.Limit(2).IDs(setContextOp(ctx, thq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{todohistory.Label}
+	default:
+		err = &NotSingularError{todohistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (thq *TodoHistoryQuery) OnlyIDX(ctx context.Context) string {
+	id, err := thq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TodoHistories.
+func (thq *TodoHistoryQuery) All(ctx context.Context) ([]*TodoHistory, error) {
+	ctx = setContextOp(ctx, thq.ctx, ent.OpQueryAll)
+	if err := thq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TodoHistory, *TodoHistoryQuery]()
+	return withInterceptors[[]*TodoHistory](ctx, thq, qr, thq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (thq *TodoHistoryQuery) AllX(ctx context.Context) []*TodoHistory {
+	nodes, err := thq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TodoHistory IDs.
+func (thq *TodoHistoryQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if thq.ctx.Unique == nil && thq.path != nil {
+		thq.Unique(true)
+	}
+	ctx = setContextOp(ctx, thq.ctx, ent.OpQueryIDs)
+	if err = thq.Select(todohistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (thq *TodoHistoryQuery) IDsX(ctx context.Context) []string {
+	ids, err := thq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (thq *TodoHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, thq.ctx, ent.OpQueryCount)
+	if err := thq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, thq, querierCount[*TodoHistoryQuery](), thq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (thq *TodoHistoryQuery) CountX(ctx context.Context) int {
+	count, err := thq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (thq *TodoHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, thq.ctx, ent.OpQueryExist)
+	switch _, err := thq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (thq *TodoHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := thq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TodoHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (thq *TodoHistoryQuery) Clone() *TodoHistoryQuery {
+	if thq == nil {
+		return nil
+	}
+	return &TodoHistoryQuery{
+		config:     thq.config,
+		ctx:        thq.ctx.Clone(),
+		order:      append([]todohistory.OrderOption{}, thq.order...),
+		inters:     append([]Interceptor{}, thq.inters...),
+		predicates: append([]predicate.TodoHistory{}, thq.predicates...),
+		// clone intermediate query.
+		sql:  thq.sql.Clone(),
+		path: thq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		HistoryTime time.Time `json:"history_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TodoHistory.Query().
+//		GroupBy(todohistory.FieldHistoryTime).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (thq *TodoHistoryQuery) GroupBy(field string, fields ...string) *TodoHistoryGroupBy {
+	thq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TodoHistoryGroupBy{build: thq}
+	grbuild.flds = &thq.ctx.Fields
+	grbuild.label = todohistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		HistoryTime time.Time `json:"history_time,omitempty"`
+//	}
+//
+//	client.TodoHistory.Query().
+//		Select(todohistory.FieldHistoryTime).
+//		Scan(ctx, &v)
+func (thq *TodoHistoryQuery) Select(fields ...string) *TodoHistorySelect {
+	thq.ctx.Fields = append(thq.ctx.Fields, fields...)
+	sbuild := &TodoHistorySelect{TodoHistoryQuery: thq}
+	sbuild.label = todohistory.Label
+	sbuild.flds, sbuild.scan = &thq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TodoHistorySelect configured with the given aggregations.
+func (thq *TodoHistoryQuery) Aggregate(fns ...AggregateFunc) *TodoHistorySelect {
+	return thq.Select().Aggregate(fns...)
+}
+
+func (thq *TodoHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range thq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, thq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range thq.ctx.Fields {
+		if !todohistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if thq.path != nil {
+		prev, err := thq.path(ctx)
+		if err != nil {
+			return err
+		}
+		thq.sql = prev
+	}
+	return nil
+}
+
+func (thq *TodoHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TodoHistory, error) {
+	var (
+		nodes = []*TodoHistory{}
+		_spec = thq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TodoHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TodoHistory{config: thq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = thq.schemaConfig.TodoHistory
+	ctx = internal.NewSchemaConfigContext(ctx, thq.schemaConfig)
+	if len(thq.modifiers) > 0 {
+		_spec.Modifiers = thq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, thq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range thq.loadTotal {
+		if err := thq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (thq *TodoHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := thq.querySpec()
+	_spec.Node.Schema = thq.schemaConfig.TodoHistory
+	ctx = internal.NewSchemaConfigContext(ctx, thq.schemaConfig)
+	if len(thq.modifiers) > 0 {
+		_spec.Modifiers = thq.modifiers
+	}
+	_spec.Node.Columns = thq.ctx.Fields
+	if len(thq.ctx.Fields) > 0 {
+		_spec.Unique = thq.ctx.Unique != nil && *thq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, thq.driver, _spec)
+}
+
+func (thq *TodoHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(todohistory.Table, todohistory.Columns, sqlgraph.NewFieldSpec(todohistory.FieldID, field.TypeString))
+	_spec.From = thq.sql
+	if unique := thq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if thq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := thq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todohistory.FieldID)
+		for i := range fields {
+			if fields[i] != todohistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := thq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := thq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := thq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := thq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (thq *TodoHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(thq.driver.Dialect())
+	t1 := builder.Table(todohistory.Table)
+	columns := thq.ctx.Fields
+	if len(columns) == 0 {
+		columns = todohistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if thq.sql != nil {
+		selector = thq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if thq.ctx.Unique != nil && *thq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(thq.schemaConfig.TodoHistory)
+	ctx = internal.NewSchemaConfigContext(ctx, thq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range thq.modifiers {
+		m(selector)
+	}
+	for _, p := range thq.predicates {
+		p(selector)
+	}
+	for _, p := range thq.order {
+		p(selector)
+	}
+	if offset := thq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := thq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (thq *TodoHistoryQuery) Modify(modifiers ...func(s *sql.Selector)) *TodoHistorySelect {
+	thq.modifiers = append(thq.modifiers, modifiers...)
+	return thq.Select()
+}
+
+// TodoHistoryGroupBy is the group-by builder for TodoHistory entities.
+type TodoHistoryGroupBy struct {
+	selector
+	build *TodoHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (thgb *TodoHistoryGroupBy) Aggregate(fns ...AggregateFunc) *TodoHistoryGroupBy {
+	thgb.fns = append(thgb.fns, fns...)
+	return thgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (thgb *TodoHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, thgb.build.ctx, ent.OpQueryGroupBy)
+	if err := thgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoHistoryQuery, *TodoHistoryGroupBy](ctx, thgb.build, thgb, thgb.build.inters, v)
+}
+
+func (thgb *TodoHistoryGroupBy) sqlScan(ctx context.Context, root *TodoHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(thgb.fns))
+	for _, fn := range thgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*thgb.flds)+len(thgb.fns))
+		for _, f := range *thgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*thgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := thgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TodoHistorySelect is the builder for selecting fields of TodoHistory entities.
+type TodoHistorySelect struct {
+	*TodoHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ths *TodoHistorySelect) Aggregate(fns ...AggregateFunc) *TodoHistorySelect {
+	ths.fns = append(ths.fns, fns...)
+	return ths
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ths *TodoHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ths.ctx, ent.OpQuerySelect)
+	if err := ths.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoHistoryQuery, *TodoHistorySelect](ctx, ths.TodoHistoryQuery, ths, ths.inters, v)
+}
+
+func (ths *TodoHistorySelect) sqlScan(ctx context.Context, root *TodoHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ths.fns))
+	for _, fn := range ths.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ths.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ths.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ths *TodoHistorySelect) Modify(modifiers ...func(s *sql.Selector)) *TodoHistorySelect {
+	ths.modifiers = append(ths.modifiers, modifiers...)
+	return ths
+}
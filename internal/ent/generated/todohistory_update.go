@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+
+	"github.com/datumforge/go-template/internal/ent/generated/internal"
+)
+
+// TodoHistoryUpdate is the builder for updating TodoHistory entities.
+type TodoHistoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TodoHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TodoHistoryUpdate builder.
+func (thu *TodoHistoryUpdate) Where(ps ...predicate.TodoHistory) *TodoHistoryUpdate {
+	thu.mutation.Where(ps...)
+	return thu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (thu *TodoHistoryUpdate) SetUpdatedAt(t time.Time) *TodoHistoryUpdate {
+	thu.mutation.SetUpdatedAt(t)
+	return thu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (thu *TodoHistoryUpdate) ClearUpdatedAt() *TodoHistoryUpdate {
+	thu.mutation.ClearUpdatedAt()
+	return thu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (thu *TodoHistoryUpdate) SetUpdatedBy(s string) *TodoHistoryUpdate {
+	thu.mutation.SetUpdatedBy(s)
+	return thu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableUpdatedBy(s *string) *TodoHistoryUpdate {
+	if s != nil {
+		thu.SetUpdatedBy(*s)
+	}
+	return thu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (thu *TodoHistoryUpdate) ClearUpdatedBy() *TodoHistoryUpdate {
+	thu.mutation.ClearUpdatedBy()
+	return thu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (thu *TodoHistoryUpdate) SetDeletedAt(t time.Time) *TodoHistoryUpdate {
+	thu.mutation.SetDeletedAt(t)
+	return thu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableDeletedAt(t *time.Time) *TodoHistoryUpdate {
+	if t != nil {
+		thu.SetDeletedAt(*t)
+	}
+	return thu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (thu *TodoHistoryUpdate) ClearDeletedAt() *TodoHistoryUpdate {
+	thu.mutation.ClearDeletedAt()
+	return thu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (thu *TodoHistoryUpdate) SetDeletedBy(s string) *TodoHistoryUpdate {
+	thu.mutation.SetDeletedBy(s)
+	return thu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableDeletedBy(s *string) *TodoHistoryUpdate {
+	if s != nil {
+		thu.SetDeletedBy(*s)
+	}
+	return thu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (thu *TodoHistoryUpdate) ClearDeletedBy() *TodoHistoryUpdate {
+	thu.mutation.ClearDeletedBy()
+	return thu
+}
+
+// SetName sets the "name" field.
+func (thu *TodoHistoryUpdate) SetName(s string) *TodoHistoryUpdate {
+	thu.mutation.SetName(s)
+	return thu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableName(s *string) *TodoHistoryUpdate {
+	if s != nil {
+		thu.SetName(*s)
+	}
+	return thu
+}
+
+// SetDescription sets the "description" field.
+func (thu *TodoHistoryUpdate) SetDescription(s string) *TodoHistoryUpdate {
+	thu.mutation.SetDescription(s)
+	return thu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableDescription(s *string) *TodoHistoryUpdate {
+	if s != nil {
+		thu.SetDescription(*s)
+	}
+	return thu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (thu *TodoHistoryUpdate) ClearDescription() *TodoHistoryUpdate {
+	thu.mutation.ClearDescription()
+	return thu
+}
+
+// SetStatus sets the "status" field.
+func (thu *TodoHistoryUpdate) SetStatus(t todohistory.Status) *TodoHistoryUpdate {
+	thu.mutation.SetStatus(t)
+	return thu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableStatus(t *todohistory.Status) *TodoHistoryUpdate {
+	if t != nil {
+		thu.SetStatus(*t)
+	}
+	return thu
+}
+
+// SetPriority sets the "priority" field.
+func (thu *TodoHistoryUpdate) SetPriority(i int) *TodoHistoryUpdate {
+	thu.mutation.ResetPriority()
+	thu.mutation.SetPriority(i)
+	return thu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillablePriority(i *int) *TodoHistoryUpdate {
+	if i != nil {
+		thu.SetPriority(*i)
+	}
+	return thu
+}
+
+// AddPriority adds i to the "priority" field.
+func (thu *TodoHistoryUpdate) AddPriority(i int) *TodoHistoryUpdate {
+	thu.mutation.AddPriority(i)
+	return thu
+}
+
+// SetDueDate sets the "due_date" field.
+func (thu *TodoHistoryUpdate) SetDueDate(t time.Time) *TodoHistoryUpdate {
+	thu.mutation.SetDueDate(t)
+	return thu
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableDueDate(t *time.Time) *TodoHistoryUpdate {
+	if t != nil {
+		thu.SetDueDate(*t)
+	}
+	return thu
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (thu *TodoHistoryUpdate) ClearDueDate() *TodoHistoryUpdate {
+	thu.mutation.ClearDueDate()
+	return thu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (thu *TodoHistoryUpdate) SetCompletedAt(t time.Time) *TodoHistoryUpdate {
+	thu.mutation.SetCompletedAt(t)
+	return thu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableCompletedAt(t *time.Time) *TodoHistoryUpdate {
+	if t != nil {
+		thu.SetCompletedAt(*t)
+	}
+	return thu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (thu *TodoHistoryUpdate) ClearCompletedAt() *TodoHistoryUpdate {
+	thu.mutation.ClearCompletedAt()
+	return thu
+}
+
+// SetVersion sets the "version" field.
+func (thu *TodoHistoryUpdate) SetVersion(i int) *TodoHistoryUpdate {
+	thu.mutation.ResetVersion()
+	thu.mutation.SetVersion(i)
+	return thu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (thu *TodoHistoryUpdate) SetNillableVersion(i *int) *TodoHistoryUpdate {
+	if i != nil {
+		thu.SetVersion(*i)
+	}
+	return thu
+}
+
+// AddVersion adds i to the "version" field.
+func (thu *TodoHistoryUpdate) AddVersion(i int) *TodoHistoryUpdate {
+	thu.mutation.AddVersion(i)
+	return thu
+}
+
+// Mutation returns the TodoHistoryMutation object of the builder.
+func (thu *TodoHistoryUpdate) Mutation() *TodoHistoryMutation {
+	return thu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (thu *TodoHistoryUpdate) Save(ctx context.Context) (int, error) {
+	thu.defaults()
+	return withHooks(ctx, thu.sqlSave, thu.mutation, thu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thu *TodoHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := thu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (thu *TodoHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := thu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thu *TodoHistoryUpdate) ExecX(ctx context.Context) {
+	if err := thu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (thu *TodoHistoryUpdate) defaults() {
+	if _, ok := thu.mutation.UpdatedAt(); !ok && !thu.mutation.UpdatedAtCleared() {
+		v := todohistory.UpdateDefaultUpdatedAt()
+		thu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thu *TodoHistoryUpdate) check() error {
+	if v, ok := thu.mutation.Status(); ok {
+		if err := todohistory.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "TodoHistory.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (thu *TodoHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TodoHistoryUpdate {
+	thu.modifiers = append(thu.modifiers, modifiers...)
+	return thu
+}
+
+func (thu *TodoHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := thu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todohistory.Table, todohistory.Columns, sqlgraph.NewFieldSpec(todohistory.FieldID, field.TypeString))
+	if ps := thu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if thu.mutation.RefCleared() {
+		_spec.ClearField(todohistory.FieldRef, field.TypeString)
+	}
+	if thu.mutation.CreatedAtCleared() {
+		_spec.ClearField(todohistory.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := thu.mutation.UpdatedAt(); ok {
+		_spec.SetField(todohistory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if thu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(todohistory.FieldUpdatedAt, field.TypeTime)
+	}
+	if thu.mutation.CreatedByCleared() {
+		_spec.ClearField(todohistory.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := thu.mutation.UpdatedBy(); ok {
+		_spec.SetField(todohistory.FieldUpdatedBy, field.TypeString, value)
+	}
+	if thu.mutation.UpdatedByCleared() {
+		_spec.ClearField(todohistory.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := thu.mutation.DeletedAt(); ok {
+		_spec.SetField(todohistory.FieldDeletedAt, field.TypeTime, value)
+	}
+	if thu.mutation.DeletedAtCleared() {
+		_spec.ClearField(todohistory.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := thu.mutation.DeletedBy(); ok {
+		_spec.SetField(todohistory.FieldDeletedBy, field.TypeString, value)
+	}
+	if thu.mutation.DeletedByCleared() {
+		_spec.ClearField(todohistory.FieldDeletedBy, field.TypeString)
+	}
+	if thu.mutation.OwnerIDCleared() {
+		_spec.ClearField(todohistory.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := thu.mutation.Name(); ok {
+		_spec.SetField(todohistory.FieldName, field.TypeString, value)
+	}
+	if value, ok := thu.mutation.Description(); ok {
+		_spec.SetField(todohistory.FieldDescription, field.TypeString, value)
+	}
+	if thu.mutation.DescriptionCleared() {
+		_spec.ClearField(todohistory.FieldDescription, field.TypeString)
+	}
+	if value, ok := thu.mutation.Status(); ok {
+		_spec.SetField(todohistory.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := thu.mutation.Priority(); ok {
+		_spec.SetField(todohistory.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.AddedPriority(); ok {
+		_spec.AddField(todohistory.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.DueDate(); ok {
+		_spec.SetField(todohistory.FieldDueDate, field.TypeTime, value)
+	}
+	if thu.mutation.DueDateCleared() {
+		_spec.ClearField(todohistory.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := thu.mutation.CompletedAt(); ok {
+		_spec.SetField(todohistory.FieldCompletedAt, field.TypeTime, value)
+	}
+	if thu.mutation.CompletedAtCleared() {
+		_spec.ClearField(todohistory.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := thu.mutation.Version(); ok {
+		_spec.SetField(todohistory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.AddedVersion(); ok {
+		_spec.AddField(todohistory.FieldVersion, field.TypeInt, value)
+	}
+	_spec.Node.Schema = thu.schemaConfig.TodoHistory
+	ctx = internal.NewSchemaConfigContext(ctx, thu.schemaConfig)
+	_spec.AddModifiers(thu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, thu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todohistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	thu.mutation.done = true
+	return n, nil
+}
+
+// TodoHistoryUpdateOne is the builder for updating a single TodoHistory entity.
+type TodoHistoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TodoHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (thuo *TodoHistoryUpdateOne) SetUpdatedAt(t time.Time) *TodoHistoryUpdateOne {
+	thuo.mutation.SetUpdatedAt(t)
+	return thuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (thuo *TodoHistoryUpdateOne) ClearUpdatedAt() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearUpdatedAt()
+	return thuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (thuo *TodoHistoryUpdateOne) SetUpdatedBy(s string) *TodoHistoryUpdateOne {
+	thuo.mutation.SetUpdatedBy(s)
+	return thuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableUpdatedBy(s *string) *TodoHistoryUpdateOne {
+	if s != nil {
+		thuo.SetUpdatedBy(*s)
+	}
+	return thuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (thuo *TodoHistoryUpdateOne) ClearUpdatedBy() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearUpdatedBy()
+	return thuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (thuo *TodoHistoryUpdateOne) SetDeletedAt(t time.Time) *TodoHistoryUpdateOne {
+	thuo.mutation.SetDeletedAt(t)
+	return thuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoHistoryUpdateOne {
+	if t != nil {
+		thuo.SetDeletedAt(*t)
+	}
+	return thuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (thuo *TodoHistoryUpdateOne) ClearDeletedAt() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearDeletedAt()
+	return thuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (thuo *TodoHistoryUpdateOne) SetDeletedBy(s string) *TodoHistoryUpdateOne {
+	thuo.mutation.SetDeletedBy(s)
+	return thuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableDeletedBy(s *string) *TodoHistoryUpdateOne {
+	if s != nil {
+		thuo.SetDeletedBy(*s)
+	}
+	return thuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (thuo *TodoHistoryUpdateOne) ClearDeletedBy() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearDeletedBy()
+	return thuo
+}
+
+// SetName sets the "name" field.
+func (thuo *TodoHistoryUpdateOne) SetName(s string) *TodoHistoryUpdateOne {
+	thuo.mutation.SetName(s)
+	return thuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableName(s *string) *TodoHistoryUpdateOne {
+	if s != nil {
+		thuo.SetName(*s)
+	}
+	return thuo
+}
+
+// SetDescription sets the "description" field.
+func (thuo *TodoHistoryUpdateOne) SetDescription(s string) *TodoHistoryUpdateOne {
+	thuo.mutation.SetDescription(s)
+	return thuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableDescription(s *string) *TodoHistoryUpdateOne {
+	if s != nil {
+		thuo.SetDescription(*s)
+	}
+	return thuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (thuo *TodoHistoryUpdateOne) ClearDescription() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearDescription()
+	return thuo
+}
+
+// SetStatus sets the "status" field.
+func (thuo *TodoHistoryUpdateOne) SetStatus(t todohistory.Status) *TodoHistoryUpdateOne {
+	thuo.mutation.SetStatus(t)
+	return thuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableStatus(t *todohistory.Status) *TodoHistoryUpdateOne {
+	if t != nil {
+		thuo.SetStatus(*t)
+	}
+	return thuo
+}
+
+// SetPriority sets the "priority" field.
+func (thuo *TodoHistoryUpdateOne) SetPriority(i int) *TodoHistoryUpdateOne {
+	thuo.mutation.ResetPriority()
+	thuo.mutation.SetPriority(i)
+	return thuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillablePriority(i *int) *TodoHistoryUpdateOne {
+	if i != nil {
+		thuo.SetPriority(*i)
+	}
+	return thuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (thuo *TodoHistoryUpdateOne) AddPriority(i int) *TodoHistoryUpdateOne {
+	thuo.mutation.AddPriority(i)
+	return thuo
+}
+
+// SetDueDate sets the "due_date" field.
+func (thuo *TodoHistoryUpdateOne) SetDueDate(t time.Time) *TodoHistoryUpdateOne {
+	thuo.mutation.SetDueDate(t)
+	return thuo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableDueDate(t *time.Time) *TodoHistoryUpdateOne {
+	if t != nil {
+		thuo.SetDueDate(*t)
+	}
+	return thuo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (thuo *TodoHistoryUpdateOne) ClearDueDate() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearDueDate()
+	return thuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (thuo *TodoHistoryUpdateOne) SetCompletedAt(t time.Time) *TodoHistoryUpdateOne {
+	thuo.mutation.SetCompletedAt(t)
+	return thuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableCompletedAt(t *time.Time) *TodoHistoryUpdateOne {
+	if t != nil {
+		thuo.SetCompletedAt(*t)
+	}
+	return thuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (thuo *TodoHistoryUpdateOne) ClearCompletedAt() *TodoHistoryUpdateOne {
+	thuo.mutation.ClearCompletedAt()
+	return thuo
+}
+
+// SetVersion sets the "version" field.
+func (thuo *TodoHistoryUpdateOne) SetVersion(i int) *TodoHistoryUpdateOne {
+	thuo.mutation.ResetVersion()
+	thuo.mutation.SetVersion(i)
+	return thuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (thuo *TodoHistoryUpdateOne) SetNillableVersion(i *int) *TodoHistoryUpdateOne {
+	if i != nil {
+		thuo.SetVersion(*i)
+	}
+	return thuo
+}
+
+// AddVersion adds i to the "version" field.
+func (thuo *TodoHistoryUpdateOne) AddVersion(i int) *TodoHistoryUpdateOne {
+	thuo.mutation.AddVersion(i)
+	return thuo
+}
+
+// Mutation returns the TodoHistoryMutation object of the builder.
+func (thuo *TodoHistoryUpdateOne) Mutation() *TodoHistoryMutation {
+	return thuo.mutation
+}
+
+// Where appends a list predicates to the TodoHistoryUpdate builder.
+func (thuo *TodoHistoryUpdateOne) Where(ps ...predicate.TodoHistory) *TodoHistoryUpdateOne {
+	thuo.mutation.Where(ps...)
+	return thuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (thuo *TodoHistoryUpdateOne) Select(field string, fields ...string) *TodoHistoryUpdateOne {
+	thuo.fields = append([]string{field}, fields...)
+	return thuo
+}
+
+// Save executes the query and returns the updated TodoHistory entity.
+func (thuo *TodoHistoryUpdateOne) Save(ctx context.Context) (*TodoHistory, error) {
+	thuo.defaults()
+	return withHooks(ctx, thuo.sqlSave, thuo.mutation, thuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thuo *TodoHistoryUpdateOne) SaveX(ctx context.Context) *TodoHistory {
+	node, err := thuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (thuo *TodoHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := thuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thuo *TodoHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := thuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (thuo *TodoHistoryUpdateOne) defaults() {
+	if _, ok := thuo.mutation.UpdatedAt(); !ok && !thuo.mutation.UpdatedAtCleared() {
+		v := todohistory.UpdateDefaultUpdatedAt()
+		thuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thuo *TodoHistoryUpdateOne) check() error {
+	if v, ok := thuo.mutation.Status(); ok {
+		if err := todohistory.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "TodoHistory.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (thuo *TodoHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TodoHistoryUpdateOne {
+	thuo.modifiers = append(thuo.modifiers, modifiers...)
+	return thuo
+}
+
+func (thuo *TodoHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TodoHistory, err error) {
+	if err := thuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todohistory.Table, todohistory.Columns, sqlgraph.NewFieldSpec(todohistory.FieldID, field.TypeString))
+	id, ok := thuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TodoHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := thuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todohistory.FieldID)
+		for _, f := range fields {
+			if !todohistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != todohistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := thuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if thuo.mutation.RefCleared() {
+		_spec.ClearField(todohistory.FieldRef, field.TypeString)
+	}
+	if thuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(todohistory.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := thuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(todohistory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if thuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(todohistory.FieldUpdatedAt, field.TypeTime)
+	}
+	if thuo.mutation.CreatedByCleared() {
+		_spec.ClearField(todohistory.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := thuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(todohistory.FieldUpdatedBy, field.TypeString, value)
+	}
+	if thuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(todohistory.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := thuo.mutation.DeletedAt(); ok {
+		_spec.SetField(todohistory.FieldDeletedAt, field.TypeTime, value)
+	}
+	if thuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(todohistory.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := thuo.mutation.DeletedBy(); ok {
+		_spec.SetField(todohistory.FieldDeletedBy, field.TypeString, value)
+	}
+	if thuo.mutation.DeletedByCleared() {
+		_spec.ClearField(todohistory.FieldDeletedBy, field.TypeString)
+	}
+	if thuo.mutation.OwnerIDCleared() {
+		_spec.ClearField(todohistory.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := thuo.mutation.Name(); ok {
+		_spec.SetField(todohistory.FieldName, field.TypeString, value)
+	}
+	if value, ok := thuo.mutation.Description(); ok {
+		_spec.SetField(todohistory.FieldDescription, field.TypeString, value)
+	}
+	if thuo.mutation.DescriptionCleared() {
+		_spec.ClearField(todohistory.FieldDescription, field.TypeString)
+	}
+	if value, ok := thuo.mutation.Status(); ok {
+		_spec.SetField(todohistory.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := thuo.mutation.Priority(); ok {
+		_spec.SetField(todohistory.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.AddedPriority(); ok {
+		_spec.AddField(todohistory.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.DueDate(); ok {
+		_spec.SetField(todohistory.FieldDueDate, field.TypeTime, value)
+	}
+	if thuo.mutation.DueDateCleared() {
+		_spec.ClearField(todohistory.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := thuo.mutation.CompletedAt(); ok {
+		_spec.SetField(todohistory.FieldCompletedAt, field.TypeTime, value)
+	}
+	if thuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(todohistory.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := thuo.mutation.Version(); ok {
+		_spec.SetField(todohistory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.AddedVersion(); ok {
+		_spec.AddField(todohistory.FieldVersion, field.TypeInt, value)
+	}
+	_spec.Node.Schema = thuo.schemaConfig.TodoHistory
+	ctx = internal.NewSchemaConfigContext(ctx, thuo.schemaConfig)
+	_spec.AddModifiers(thuo.modifiers...)
+	_node = &TodoHistory{config: thuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, thuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todohistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	thuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,78 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/pkg/auth"
+
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+)
+
+// Actors recorded when the mutation is not made by an authenticated user
+const (
+	// ActorSystem is the actor used by the CLI and background jobs
+	ActorSystem = "system"
+	// ActorUnknown is recorded when no actor can be found in the context
+	ActorUnknown = "unknown"
+)
+
+// actorCtxKey is the context key for the actor set by WithActor
+type actorCtxKey struct{}
+
+// WithActor returns a new context with the actor recorded in the audit fields, it is used by the CLI
+// and background jobs which do not have an authenticated user, e.g. WithActor(ctx, ActorSystem)
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, actor)
+}
+
+// ActorFromContext returns the actor set with WithActor, or the authenticated user of the request
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorCtxKey{}).(string); ok && actor != "" {
+		return actor
+	}
+
+	// GetUserIDFromContext only returns user ids that are bare ulids, the subject is used as is
+	if au, err := auth.GetAuthenticatedUserContext(ctx); err == nil && au.SubjectID != "" {
+		return au.SubjectID
+	}
+
+	return ActorUnknown
+}
+
+// auditMutation is implemented by the mutations of schemas using the audit mixin
+type auditMutation interface {
+	ent.Mutation
+	SetCreatedAt(time.Time)
+	SetUpdatedAt(time.Time)
+	SetCreatedBy(string)
+	SetUpdatedBy(string)
+}
+
+// HookAudit sets the created and updated timestamps and actors of the object
+func HookAudit() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			am, ok := m.(auditMutation)
+			if !ok {
+				return nil, fmt.Errorf("%w: %T", ErrUnexpectedAuditMutation, m)
+			}
+
+			actor := ActorFromContext(ctx)
+			now := time.Now()
+
+			if m.Op().Is(ent.OpCreate) {
+				am.SetCreatedAt(now)
+				am.SetCreatedBy(actor)
+			}
+
+			am.SetUpdatedAt(now)
+			am.SetUpdatedBy(actor)
+
+			return next.Mutate(ctx, am)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
@@ -0,0 +1,128 @@
+package hooks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestActorFromContext(t *testing.T) {
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantActor string
+	}{
+		{
+			name:      "authenticated user",
+			ctx:       testutils.UserContext("user_01", "org_01"),
+			wantActor: "user_01",
+		},
+		{
+			name:      "actor of the context",
+			ctx:       hooks.WithActor(context.Background(), hooks.ActorSystem),
+			wantActor: hooks.ActorSystem,
+		},
+		{
+			name:      "actor of the context takes precedence over the user",
+			ctx:       hooks.WithActor(testutils.UserContext("user_01", "org_01"), hooks.ActorSystem),
+			wantActor: hooks.ActorSystem,
+		},
+		{
+			name:      "empty actor",
+			ctx:       hooks.WithActor(testutils.UserContext("user_01", "org_01"), ""),
+			wantActor: "user_01",
+		},
+		{
+			name:      "no actor",
+			ctx:       context.Background(),
+			wantActor: hooks.ActorUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantActor, hooks.ActorFromContext(tt.ctx))
+		})
+	}
+}
+
+func TestHookAudit(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	owner := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	admin := client.User.Create().SetEmail("admin@acme.com").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).SetRole(orgmembership.RoleADMIN).SaveX(system)
+
+	ownerCtx := testutils.UserContext(owner.ID, org.ID)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		wantUpdatedBy string
+	}{
+		{
+			name:          "update by the creator",
+			ctx:           ownerCtx,
+			wantUpdatedBy: owner.ID,
+		},
+		{
+			name:          "update by an admin",
+			ctx:           testutils.UserContext(admin.ID, org.ID),
+			wantUpdatedBy: admin.ID,
+		},
+		{
+			name:          "update by the system",
+			ctx:           hooks.WithActor(system, hooks.ActorSystem),
+			wantUpdatedBy: hooks.ActorSystem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now()
+
+			td := client.Todo.Create().SetName(tt.name).SaveX(ownerCtx)
+
+			assert.Equal(t, owner.ID, td.CreatedBy)
+			assert.Equal(t, owner.ID, td.UpdatedBy)
+			assert.False(t, td.CreatedAt.Before(before))
+			assert.False(t, td.UpdatedAt.Before(td.CreatedAt))
+
+			updated := client.Todo.UpdateOne(td).SetDescription("changed").SaveX(tt.ctx)
+
+			// the creation is kept, the update is recorded
+			assert.Equal(t, owner.ID, updated.CreatedBy)
+			assert.True(t, updated.CreatedAt.Equal(td.CreatedAt))
+			assert.Equal(t, tt.wantUpdatedBy, updated.UpdatedBy)
+			assert.False(t, updated.UpdatedAt.Before(td.UpdatedAt))
+
+			// the fields are stored
+			stored := client.Todo.GetX(system, td.ID)
+			assert.Equal(t, owner.ID, stored.CreatedBy)
+			assert.Equal(t, tt.wantUpdatedBy, stored.UpdatedBy)
+		})
+	}
+
+	t.Run("bulk update", func(t *testing.T) {
+		td := client.Todo.Create().SetName("bulk").SaveX(ownerCtx)
+
+		n := client.Todo.Update().SetDescription("bulk changed").SaveX(hooks.WithActor(system, hooks.ActorSystem))
+		require.Positive(t, n)
+
+		stored := client.Todo.GetX(system, td.ID)
+		assert.Equal(t, owner.ID, stored.CreatedBy)
+		assert.Equal(t, hooks.ActorSystem, stored.UpdatedBy)
+	})
+}
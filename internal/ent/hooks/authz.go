@@ -0,0 +1,267 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"github.com/datumforge/fgax"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/ids"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// Object types of the authorization model, see fga/model/datum.fga
+const (
+	// UserObjectType is the type of the users in the authorization model
+	UserObjectType fgax.Kind = "user"
+	// OrganizationObjectType is the type of the organizations in the authorization model
+	OrganizationObjectType fgax.Kind = "organization"
+	// TodoObjectType is the type of the todos in the authorization model
+	TodoObjectType fgax.Kind = "todo"
+)
+
+// maxTupleWrites is the maximum number of tuples written or deleted in a single transactional write to openFGA
+const maxTupleWrites = 10
+
+// HookTodoAuthz writes the relationship tuples of created todos to openFGA, the organization owning the todo is
+// its parent and the user that created it its owner; the tuples are removed again when the transaction creating
+// the todo is rolled back. The tuples are deleted once the todos are removed for good, soft deleted todos keep
+// their tuples so they can still be restored
+func HookTodoAuthz() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *generated.TodoMutation) (generated.Value, error) {
+			// authorization is not enabled, nothing to do
+			if m.Authz.Ofga == nil {
+				return next.Mutate(ctx, m)
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				t, ok := v.(*generated.Todo)
+				if !ok {
+					return nil, fmt.Errorf("%w: %T", ErrUnexpectedMutationValue, v)
+				}
+
+				// the todo is not created when the tuples can not be written
+				tuples := todoTuples(t)
+				if err := writeTuples(ctx, m.Authz, tuples, nil); err != nil {
+					return nil, err
+				}
+
+				revertTuplesOnRollback(ctx, m, m.Authz, &m.Logger, tuples, nil)
+
+				return v, nil
+			}
+
+			// get the todos before the mutation, deleted objects can't be queried afterwards
+			todoIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			todos, err := m.Client().Todo.Query().
+				Where(todo.IDIn(todoIDs...)).
+				Select(todo.FieldID, todo.FieldOwnerID, todo.FieldCreatedBy).
+				All(interceptors.WithDeleted(ctx))
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			var tuples []fgax.TupleKey
+			for _, t := range todos {
+				tuples = append(tuples, todoTuples(t)...)
+			}
+
+			deleteTuplesOnCommit(ctx, m, m.Authz, &m.Logger, tuples)
+
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// HookOrgMembershipAuthz keeps the role of each member of an organization in sync with openFGA, the role is written
+// as the relation of the user with the organization, e.g. `user:<id>` is `admin` of `organization:<id>`
+func HookOrgMembershipAuthz() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.OrgMembershipFunc(func(ctx context.Context, m *generated.OrgMembershipMutation) (generated.Value, error) {
+			// authorization is not enabled, nothing to do
+			if m.Authz.Ofga == nil {
+				return next.Mutate(ctx, m)
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				om, ok := v.(*generated.OrgMembership)
+				if !ok {
+					return nil, fmt.Errorf("%w: %T", ErrUnexpectedMutationValue, v)
+				}
+
+				// the membership is not created when the tuple can not be written
+				tuples := []fgax.TupleKey{membershipTuple(om)}
+				if err := writeTuples(ctx, m.Authz, tuples, nil); err != nil {
+					return nil, err
+				}
+
+				revertTuplesOnRollback(ctx, m, m.Authz, &m.Logger, tuples, nil)
+
+				return v, nil
+			}
+
+			// only changes of the role need to be written
+			role, roleChanged := m.Role()
+			if m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && !roleChanged {
+				return next.Mutate(ctx, m)
+			}
+
+			// get the memberships before the mutation, the previous role can't be queried afterwards
+			membershipIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			memberships, err := m.Client().OrgMembership.Query().
+				Where(orgmembership.IDIn(membershipIDs...)).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			var writes, deletes []fgax.TupleKey
+
+			for _, om := range memberships {
+				if roleChanged && om.Role == role {
+					continue
+				}
+
+				deletes = append(deletes, membershipTuple(om))
+
+				if roleChanged {
+					om.Role = role
+					writes = append(writes, membershipTuple(om))
+				}
+			}
+
+			// removed members lose access once the removal is committed, role changes are written right away
+			// so the membership is not changed when the tuples can not be written
+			if !roleChanged {
+				deleteTuplesOnCommit(ctx, m, m.Authz, &m.Logger, deletes)
+
+				return v, nil
+			}
+
+			if err := writeTuples(ctx, m.Authz, writes, deletes); err != nil {
+				return nil, err
+			}
+
+			revertTuplesOnRollback(ctx, m, m.Authz, &m.Logger, writes, deletes)
+
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// todoTuples returns the relationship tuples of the todo with its organization and the user that created it
+func todoTuples(t *generated.Todo) []fgax.TupleKey {
+	object := fgax.Entity{Kind: TodoObjectType, Identifier: t.ID}
+
+	tuples := []fgax.TupleKey{
+		{
+			Subject:  fgax.Entity{Kind: OrganizationObjectType, Identifier: t.OwnerID},
+			Object:   object,
+			Relation: fgax.ParentRelation,
+		},
+	}
+
+	// todos created by the system or other actors without a user do not have an owner
+	if prefix, ok := ids.Prefix(t.CreatedBy); ok && prefix == ids.UserPrefix {
+		tuples = append(tuples, fgax.TupleKey{
+			Subject:  fgax.Entity{Kind: UserObjectType, Identifier: t.CreatedBy},
+			Object:   object,
+			Relation: fgax.OwnerRelation,
+		})
+	}
+
+	return tuples
+}
+
+// membershipTuple returns the relationship tuple of the member with the organization, the relation is the role
+func membershipTuple(om *generated.OrgMembership) fgax.TupleKey {
+	return fgax.TupleKey{
+		Subject:  fgax.Entity{Kind: UserObjectType, Identifier: om.UserID},
+		Object:   fgax.Entity{Kind: OrganizationObjectType, Identifier: om.OrganizationID},
+		Relation: fgax.Relation(strings.ToLower(om.Role.String())),
+	}
+}
+
+// writeTuples writes and deletes the relationship tuples in batches of the maximum size of a transactional write
+func writeTuples(ctx context.Context, c fgax.Client, writes, deletes []fgax.TupleKey) error {
+	for len(writes) > 0 || len(deletes) > 0 {
+		w := writes[:min(len(writes), maxTupleWrites)]
+		d := deletes[:min(len(deletes), maxTupleWrites-len(w))]
+
+		if _, err := c.WriteTupleKeys(ctx, w, d); err != nil {
+			return err
+		}
+
+		writes = writes[len(w):]
+		deletes = deletes[len(d):]
+	}
+
+	return nil
+}
+
+// deleteTuplesOnCommit deletes the relationship tuples once the mutation is committed, so the objects are not
+// left without tuples when the transaction is rolled back; errors are logged as the objects are already removed
+func deleteTuplesOnCommit(ctx context.Context, m txMutation, c fgax.Client, logger *zap.SugaredLogger, tuples []fgax.TupleKey) {
+	if len(tuples) == 0 {
+		return
+	}
+
+	runOnCommit(ctx, m, func(ctx context.Context) {
+		// the request may already be done, do not cancel the delete with it
+		if err := writeTuples(context.WithoutCancel(ctx), c, nil, tuples); err != nil {
+			logger.Errorw("unable to delete relationship tuples", "error", err)
+		}
+	})
+}
+
+// revertTuplesOnRollback reverts the relationship tuples written and deleted by the mutation when its transaction
+// is rolled back, so openFGA does not keep tuples of objects that were never created; errors are logged as the
+// transaction is already rolled back
+func revertTuplesOnRollback(ctx context.Context, m txMutation, c fgax.Client, logger *zap.SugaredLogger, writes, deletes []fgax.TupleKey) {
+	if len(writes) == 0 && len(deletes) == 0 {
+		return
+	}
+
+	runOnRollback(ctx, m, func(ctx context.Context) {
+		// the request may already be done, do not cancel the revert with it
+		if err := writeTuples(context.WithoutCancel(ctx), c, deletes, writes); err != nil {
+			logger.Errorw("unable to revert relationship tuples", "error", err)
+		}
+	})
+}
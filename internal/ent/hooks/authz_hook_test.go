@@ -0,0 +1,252 @@
+package hooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datumforge/entx"
+	"github.com/datumforge/fgax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+	"github.com/datumforge/go-template/pkg/fgamem"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+// newAuthzClient returns an ent client backed by an in-memory sqlite database, with authorization checked
+// against an in-memory openFGA server
+func newAuthzClient(t *testing.T) (*generated.Client, *fgax.Client) {
+	t.Helper()
+
+	srv := fgamem.NewServer()
+	t.Cleanup(srv.Close)
+
+	fc, err := srv.NewClient(context.Background(), "../../../fga/model/datum.fga", zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	client := testutils.NewTestClient(t, generated.Authz(*fc))
+
+	return client, fc
+}
+
+// checkRelation returns whether the user has the relation with the object
+func checkRelation(t *testing.T, fc *fgax.Client, objectType fgax.Kind, objectID, userID, relation string) bool {
+	t.Helper()
+
+	allowed, err := fc.CheckAccess(context.Background(), fgax.AccessCheck{
+		ObjectType:  objectType,
+		ObjectID:    objectID,
+		SubjectID:   userID,
+		SubjectType: string(hooks.UserObjectType),
+		Relation:    relation,
+	})
+	require.NoError(t, err)
+
+	return allowed
+}
+
+func TestHookOrgMembershipAuthz(t *testing.T) {
+	client, fc := newAuthzClient(t)
+	ctx := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(ctx)
+	u := client.User.Create().SetEmail("member@acme.com").SetDisplayName("member").SaveX(ctx)
+
+	var membership *generated.OrgMembership
+
+	// the steps change the same membership, the relations are checked after each step
+	tests := []struct {
+		name   string
+		mutate func() error
+		want   map[string]bool
+	}{
+		{
+			name: "create",
+			mutate: func() (err error) {
+				membership, err = client.OrgMembership.Create().
+					SetOrganizationID(org.ID).
+					SetUserID(u.ID).
+					SetRole(orgmembership.RoleMEMBER).
+					Save(ctx)
+
+				return err
+			},
+			want: map[string]bool{"owner": false, "admin": false, "member": true},
+		},
+		{
+			name: "promote",
+			mutate: func() error {
+				return client.OrgMembership.UpdateOne(membership).SetRole(orgmembership.RoleOWNER).Exec(ctx)
+			},
+			want: map[string]bool{"owner": true, "admin": true, "member": true},
+		},
+		{
+			name: "demote",
+			mutate: func() error {
+				return client.OrgMembership.UpdateOne(membership).SetRole(orgmembership.RoleADMIN).Exec(ctx)
+			},
+			want: map[string]bool{"owner": false, "admin": true, "member": true},
+		},
+		{
+			name: "same role",
+			mutate: func() error {
+				return client.OrgMembership.UpdateOne(membership).SetRole(orgmembership.RoleADMIN).Exec(ctx)
+			},
+			want: map[string]bool{"owner": false, "admin": true, "member": true},
+		},
+		{
+			name: "delete",
+			mutate: func() error {
+				return client.OrgMembership.DeleteOne(membership).Exec(ctx)
+			},
+			want: map[string]bool{"owner": false, "admin": false, "member": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.mutate())
+
+			for relation, want := range tt.want {
+				assert.Equal(t, want, checkRelation(t, fc, hooks.OrganizationObjectType, org.ID, u.ID, relation), relation)
+			}
+		})
+	}
+}
+
+func TestHookTodoAuthz(t *testing.T) {
+	client, fc := newAuthzClient(t)
+	ctx := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(ctx)
+
+	users := map[orgmembership.Role]*generated.User{}
+
+	for _, role := range []orgmembership.Role{orgmembership.RoleADMIN, orgmembership.RoleMEMBER} {
+		users[role] = client.User.Create().SetEmail(role.String() + "@acme.com").SetDisplayName(role.String()).SaveX(ctx)
+		client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(users[role].ID).SetRole(role).SaveX(ctx)
+	}
+
+	creator := client.User.Create().SetEmail("creator@acme.com").SetDisplayName("creator").SaveX(ctx)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(creator.ID).SetRole(orgmembership.RoleMEMBER).SaveX(ctx)
+
+	todo := client.Todo.Create().SetName("todo").SaveX(testutils.UserContext(creator.ID, org.ID))
+
+	tests := []struct {
+		name string
+		user *generated.User
+		want map[string]bool
+	}{
+		{
+			name: "creator",
+			user: creator,
+			want: map[string]bool{fgax.CanView: true, fgax.CanEdit: true, fgax.CanDelete: true},
+		},
+		{
+			name: "admin of the organization",
+			user: users[orgmembership.RoleADMIN],
+			want: map[string]bool{fgax.CanView: true, fgax.CanEdit: true, fgax.CanDelete: true},
+		},
+		{
+			name: "member of the organization",
+			user: users[orgmembership.RoleMEMBER],
+			want: map[string]bool{fgax.CanView: true, fgax.CanEdit: false, fgax.CanDelete: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for relation, want := range tt.want {
+				assert.Equal(t, want, checkRelation(t, fc, hooks.TodoObjectType, todo.ID, tt.user.ID, relation), relation)
+			}
+		})
+	}
+
+	t.Run("soft deleted todos keep their tuples", func(t *testing.T) {
+		require.NoError(t, client.Todo.DeleteOne(todo).Exec(ctx))
+
+		assert.True(t, checkRelation(t, fc, hooks.TodoObjectType, todo.ID, creator.ID, fgax.CanView))
+	})
+
+	t.Run("purged todos lose their tuples", func(t *testing.T) {
+		require.NoError(t, client.Todo.DeleteOneID(todo.ID).Exec(entx.SkipSoftDelete(interceptors.WithDeleted(ctx))))
+
+		assert.False(t, checkRelation(t, fc, hooks.TodoObjectType, todo.ID, creator.ID, fgax.CanView))
+	})
+}
+
+func TestHookAuthzRollback(t *testing.T) {
+	client, fc := newAuthzClient(t)
+	ctx := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(ctx)
+	owner := client.User.Create().SetEmail("owner@acme.com").SetDisplayName("owner").SaveX(ctx)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleOWNER).SaveX(ctx)
+
+	u := client.User.Create().SetEmail("member@acme.com").SetDisplayName("member").SaveX(ctx)
+	membership := client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(orgmembership.RoleMEMBER).SaveX(ctx)
+
+	newcomer := client.User.Create().SetEmail("newcomer@acme.com").SetDisplayName("newcomer").SaveX(ctx)
+
+	// rollback runs the mutation in a transaction that is rolled back afterwards
+	rollback := func(t *testing.T, mutate func(tx *generated.Tx)) {
+		t.Helper()
+
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+
+		mutate(tx)
+
+		require.NoError(t, tx.Rollback())
+	}
+
+	t.Run("created todo", func(t *testing.T) {
+		var todo *generated.Todo
+
+		rollback(t, func(tx *generated.Tx) {
+			todo = tx.Todo.Create().SetName("todo").SaveX(testutils.UserContext(owner.ID, org.ID))
+
+			assert.True(t, checkRelation(t, fc, hooks.TodoObjectType, todo.ID, owner.ID, fgax.CanView))
+		})
+
+		assert.False(t, checkRelation(t, fc, hooks.TodoObjectType, todo.ID, owner.ID, fgax.CanView))
+	})
+
+	t.Run("created membership", func(t *testing.T) {
+		rollback(t, func(tx *generated.Tx) {
+			tx.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(newcomer.ID).SetRole(orgmembership.RoleMEMBER).SaveX(ctx)
+
+			assert.True(t, checkRelation(t, fc, hooks.OrganizationObjectType, org.ID, newcomer.ID, "member"))
+		})
+
+		assert.False(t, checkRelation(t, fc, hooks.OrganizationObjectType, org.ID, newcomer.ID, "member"))
+	})
+
+	t.Run("changed role", func(t *testing.T) {
+		rollback(t, func(tx *generated.Tx) {
+			tx.OrgMembership.UpdateOneID(membership.ID).SetRole(orgmembership.RoleADMIN).ExecX(ctx)
+
+			assert.True(t, checkRelation(t, fc, hooks.OrganizationObjectType, org.ID, u.ID, "admin"))
+		})
+
+		assert.False(t, checkRelation(t, fc, hooks.OrganizationObjectType, org.ID, u.ID, "admin"))
+		assert.True(t, checkRelation(t, fc, hooks.OrganizationObjectType, org.ID, u.ID, "member"))
+	})
+
+	t.Run("committed todo keeps its tuples", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+
+		todo := tx.Todo.Create().SetName("committed").SaveX(testutils.UserContext(owner.ID, org.ID))
+		require.NoError(t, tx.Commit())
+
+		assert.True(t, checkRelation(t, fc, hooks.TodoObjectType, todo.ID, owner.ID, fgax.CanView))
+	})
+}
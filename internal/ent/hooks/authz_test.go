@@ -0,0 +1,205 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/datumforge/fgax"
+	ofgaclient "github.com/openfga/go-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/pkg/fgamem"
+)
+
+// modelFile is the authorization model of the server, relative to the package
+const modelFile = "../../../fga/model/datum.fga"
+
+// newFGAClient returns a client of an in-memory openFGA server limited to the tuples of a transactional write
+func newFGAClient(t *testing.T) *fgax.Client {
+	t.Helper()
+
+	srv := fgamem.NewServer()
+	t.Cleanup(srv.Close)
+
+	srv.SetMaxTuplesPerWrite(maxTupleWrites)
+
+	c, err := srv.NewClient(context.Background(), modelFile, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	return c
+}
+
+// readTuples returns the relationship tuples of the object, e.g. `todo:` for all todos
+func readTuples(t *testing.T, c *fgax.Client, object string) []fgax.TupleKey {
+	t.Helper()
+
+	resp, err := c.Ofga.Read(context.Background()).Body(ofgaclient.ClientReadRequest{Object: &object}).Execute()
+	require.NoError(t, err)
+
+	tuples := make([]fgax.TupleKey, 0, len(resp.Tuples))
+
+	for _, tuple := range resp.Tuples {
+		k := tuple.Key
+
+		subject, err := fgax.ParseEntity(k.User)
+		require.NoError(t, err)
+
+		obj, err := fgax.ParseEntity(k.Object)
+		require.NoError(t, err)
+
+		tuples = append(tuples, fgax.TupleKey{Subject: subject, Object: obj, Relation: fgax.Relation(k.Relation)})
+	}
+
+	return tuples
+}
+
+func TestTodoTuples(t *testing.T) {
+	object := fgax.Entity{Kind: TodoObjectType, Identifier: "todo_01"}
+	parent := fgax.TupleKey{
+		Subject:  fgax.Entity{Kind: OrganizationObjectType, Identifier: "org_01"},
+		Object:   object,
+		Relation: fgax.ParentRelation,
+	}
+
+	tests := []struct {
+		name      string
+		createdBy string
+		want      []fgax.TupleKey
+	}{
+		{
+			name:      "created by a user",
+			createdBy: "user_01",
+			want: []fgax.TupleKey{
+				parent,
+				{
+					Subject:  fgax.Entity{Kind: UserObjectType, Identifier: "user_01"},
+					Object:   object,
+					Relation: fgax.OwnerRelation,
+				},
+			},
+		},
+		{
+			name: "created by the system",
+			want: []fgax.TupleKey{parent},
+		},
+		{
+			name:      "created by another actor",
+			createdBy: "token_01",
+			want:      []fgax.TupleKey{parent},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo := &generated.Todo{ID: "todo_01", OwnerID: "org_01", CreatedBy: tt.createdBy}
+
+			assert.Equal(t, tt.want, todoTuples(todo))
+		})
+	}
+}
+
+func TestMembershipTuple(t *testing.T) {
+	tests := []struct {
+		role orgmembership.Role
+		want fgax.Relation
+	}{
+		{
+			role: orgmembership.RoleOWNER,
+			want: "owner",
+		},
+		{
+			role: orgmembership.RoleADMIN,
+			want: "admin",
+		},
+		{
+			role: orgmembership.RoleMEMBER,
+			want: "member",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role.String(), func(t *testing.T) {
+			om := &generated.OrgMembership{OrganizationID: "org_01", UserID: "user_01", Role: tt.role}
+
+			assert.Equal(t, fgax.TupleKey{
+				Subject:  fgax.Entity{Kind: UserObjectType, Identifier: "user_01"},
+				Object:   fgax.Entity{Kind: OrganizationObjectType, Identifier: "org_01"},
+				Relation: tt.want,
+			}, membershipTuple(om))
+		})
+	}
+}
+
+func TestWriteTuples(t *testing.T) {
+	// parentTuples returns the parent tuples of n todos of the organization
+	parentTuples := func(org string, n int) []fgax.TupleKey {
+		tuples := make([]fgax.TupleKey, n)
+
+		for i := range tuples {
+			tuples[i] = todoTuples(&generated.Todo{ID: fmt.Sprintf("todo_%02d", i), OwnerID: org})[0]
+		}
+
+		return tuples
+	}
+
+	tests := []struct {
+		name     string
+		existing []fgax.TupleKey
+		writes   []fgax.TupleKey
+		deletes  []fgax.TupleKey
+		want     int
+	}{
+		{
+			name: "nothing to write",
+		},
+		{
+			name:   "single batch",
+			writes: parentTuples("org_01", maxTupleWrites),
+			want:   maxTupleWrites,
+		},
+		{
+			name:   "writes over the batch size",
+			writes: parentTuples("org_01", 2*maxTupleWrites+5),
+			want:   2*maxTupleWrites + 5,
+		},
+		{
+			name:     "deletes over the batch size",
+			existing: parentTuples("org_01", 2*maxTupleWrites+5),
+			deletes:  parentTuples("org_01", 2*maxTupleWrites+5),
+			want:     0,
+		},
+		{
+			name:     "writes and deletes over the batch size",
+			existing: parentTuples("org_01", maxTupleWrites+3),
+			writes:   parentTuples("org_02", maxTupleWrites+3),
+			deletes:  parentTuples("org_01", maxTupleWrites+3),
+			want:     maxTupleWrites + 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := newFGAClient(t)
+
+			require.NoError(t, writeTuples(ctx, *c, tt.existing, nil))
+			require.NoError(t, writeTuples(ctx, *c, tt.writes, tt.deletes))
+
+			tuples := readTuples(t, c, "todo:")
+			assert.Len(t, tuples, tt.want)
+			assert.Subset(t, tuples, tt.writes)
+		})
+	}
+
+	t.Run("without batches", func(t *testing.T) {
+		c := newFGAClient(t)
+
+		_, err := c.WriteTupleKeys(context.Background(), parentTuples("org_01", maxTupleWrites+1), nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,54 @@
+package hooks
+
+import (
+	"context"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+)
+
+// txMutation is implemented by all generated mutations
+type txMutation interface {
+	Tx() (*generated.Tx, error)
+}
+
+// runOnCommit runs fn once the transaction of the mutation is committed, or right away when the
+// mutation is not running in a transaction; fn is not run when the transaction is rolled back
+func runOnCommit(ctx context.Context, m txMutation, fn func(context.Context)) {
+	tx, err := m.Tx()
+	if err != nil {
+		fn(ctx)
+
+		return
+	}
+
+	tx.OnCommit(func(next generated.Committer) generated.Committer {
+		return generated.CommitFunc(func(ctx context.Context, tx *generated.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			fn(ctx)
+
+			return nil
+		})
+	})
+}
+
+// runOnRollback runs fn once the transaction of the mutation is rolled back, it is not run when the
+// mutation is not running in a transaction or the transaction is committed
+func runOnRollback(ctx context.Context, m txMutation, fn func(context.Context)) {
+	tx, err := m.Tx()
+	if err != nil {
+		return
+	}
+
+	tx.OnRollback(func(next generated.Rollbacker) generated.Rollbacker {
+		return generated.RollbackFunc(func(ctx context.Context, tx *generated.Tx) error {
+			err := next.Rollback(ctx, tx)
+
+			fn(ctx)
+
+			return err
+		})
+	})
+}
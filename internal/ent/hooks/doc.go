@@ -0,0 +1,2 @@
+// Package hooks contains the ent hooks used by the ent schema
+package hooks
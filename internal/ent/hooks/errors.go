@@ -0,0 +1,22 @@
+package hooks
+
+import "errors"
+
+var (
+	// ErrUnexpectedAuditMutation is returned when the audit hook is used on a schema without the audit fields
+	ErrUnexpectedAuditMutation = errors.New("unexpected mutation type for audit hook")
+	// ErrUnexpectedSoftDeleteMutation is returned when the soft delete hook is used on a schema without the soft delete fields
+	ErrUnexpectedSoftDeleteMutation = errors.New("unexpected mutation type for soft delete hook")
+	// ErrUnexpectedTenantMutation is returned when the tenant hook is used on a schema without an owner field
+	ErrUnexpectedTenantMutation = errors.New("unexpected mutation type for tenant hook")
+	// ErrUnexpectedMutationValue is returned when the value returned by a mutation is not of the expected type
+	ErrUnexpectedMutationValue = errors.New("unexpected mutation value")
+	// ErrTagNotFound is returned when a tag added to a todo does not exist
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrMissingMutationID is returned when the id of a created object is not set on the mutation
+	ErrMissingMutationID = errors.New("could not get id from mutation")
+	// ErrInvalidStatusTransition is returned when a todo is moved to a status that is not allowed from its current status
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	// ErrVersionConflict is returned when a todo is changed based on a version that is no longer current
+	ErrVersionConflict = errors.New("todo has been changed since the expected version")
+)
@@ -0,0 +1,127 @@
+package hooks
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/ids"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// HookTodoHistory records a snapshot of each created, updated and deleted todo in the todo history,
+// the history is written with the client of the mutation so it is part of the same transaction
+func HookTodoHistory() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *generated.TodoMutation) (generated.Value, error) {
+			// soft deletes are turned into updates, they are recorded when the update is made
+			if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && !entx.CheckSkipSoftDelete(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			var todoIDs []string
+
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+
+				todoIDs, err = m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// the todos no longer exist after a delete, record them before they are removed
+			if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				if err := createTodoHistory(ctx, m, todoIDs, enthistory.OpTypeDelete); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			op := enthistory.OpTypeUpdate
+
+			switch {
+			case entx.CheckIsSoftDelete(ctx):
+				op = enthistory.OpTypeDelete
+			case m.Op().Is(ent.OpCreate):
+				op = enthistory.OpTypeInsert
+
+				id, ok := m.ID()
+				if !ok {
+					return nil, ErrMissingMutationID
+				}
+
+				todoIDs = []string{id}
+			}
+
+			if err := createTodoHistory(ctx, m, todoIDs, op); err != nil {
+				return nil, err
+			}
+
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// createTodoHistory adds the current state of the todos to the todo history
+func createTodoHistory(ctx context.Context, m *generated.TodoMutation, todoIDs []string, op enthistory.OpType) error {
+	if len(todoIDs) == 0 {
+		return nil
+	}
+
+	client := m.Client()
+
+	// soft deleted todos must be included to record their deletion
+	todos, err := client.Todo.Query().Where(todo.IDIn(todoIDs...)).All(interceptors.WithDeleted(ctx))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	actor := ActorFromContext(ctx)
+
+	builders := make([]*generated.TodoHistoryCreate, 0, len(todos))
+
+	for _, t := range todos {
+		updatedBy := t.UpdatedBy
+		if op == enthistory.OpTypeDelete {
+			// the todo row is not updated on hard deletes, record who removed it
+			updatedBy = actor
+		}
+
+		builders = append(builders, client.TodoHistory.Create().
+			SetID(ids.New(ids.TodoHistoryPrefix)).
+			SetHistoryTime(now).
+			SetOperation(op).
+			SetRef(t.ID).
+			SetCreatedAt(t.CreatedAt).
+			SetUpdatedAt(t.UpdatedAt).
+			SetCreatedBy(t.CreatedBy).
+			SetUpdatedBy(updatedBy).
+			SetDeletedAt(t.DeletedAt).
+			SetDeletedBy(t.DeletedBy).
+			SetOwnerID(t.OwnerID).
+			SetName(t.Name).
+			SetDescription(t.Description).
+			SetStatus(todohistory.Status(t.Status)).
+			SetPriority(t.Priority).
+			SetNillableDueDate(t.DueDate).
+			SetNillableCompletedAt(t.CompletedAt).
+			SetVersion(t.Version))
+	}
+
+	return client.TodoHistory.CreateBulk(builders...).Exec(ctx)
+}
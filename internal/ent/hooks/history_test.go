@@ -0,0 +1,111 @@
+package hooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestHookTodoHistory(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	// the history hooks are added by the db client when the history is enabled
+	client.Todo.Use(hooks.HookTodoHistory())
+
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	u := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	ctx := testutils.UserContext(u.ID, org.ID)
+
+	tests := []struct {
+		name        string
+		mutate      func(t *testing.T, td *generated.Todo)
+		wantOps     []enthistory.OpType
+		wantVersion int
+		wantDelete  bool
+	}{
+		{
+			name:        "create",
+			mutate:      func(*testing.T, *generated.Todo) {},
+			wantOps:     []enthistory.OpType{enthistory.OpTypeInsert},
+			wantVersion: 1,
+		},
+		{
+			name: "update",
+			mutate: func(t *testing.T, td *generated.Todo) {
+				require.NoError(t, client.Todo.UpdateOne(td).SetDescription("changed").Exec(ctx))
+				require.NoError(t, client.Todo.UpdateOne(td).SetPriority(2).Exec(ctx))
+			},
+			wantOps:     []enthistory.OpType{enthistory.OpTypeInsert, enthistory.OpTypeUpdate, enthistory.OpTypeUpdate},
+			wantVersion: 3,
+		},
+		{
+			name: "bulk update",
+			mutate: func(t *testing.T, td *generated.Todo) {
+				require.NoError(t, client.Todo.Update().Where(todo.ID(td.ID)).SetDescription("changed").Exec(ctx))
+			},
+			wantOps:     []enthistory.OpType{enthistory.OpTypeInsert, enthistory.OpTypeUpdate},
+			wantVersion: 2,
+		},
+		{
+			name: "soft delete",
+			mutate: func(t *testing.T, td *generated.Todo) {
+				require.NoError(t, client.Todo.DeleteOne(td).Exec(ctx))
+			},
+			wantOps:     []enthistory.OpType{enthistory.OpTypeInsert, enthistory.OpTypeDelete},
+			wantVersion: 2,
+			wantDelete:  true,
+		},
+		{
+			name: "hard delete",
+			mutate: func(t *testing.T, td *generated.Todo) {
+				require.NoError(t, client.Todo.DeleteOneID(td.ID).Exec(entx.SkipSoftDelete(ctx)))
+			},
+			// the row is removed as it is, the deletion is recorded with the last version
+			wantOps:     []enthistory.OpType{enthistory.OpTypeInsert, enthistory.OpTypeDelete},
+			wantVersion: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := client.Todo.Create().SetName(tt.name).SaveX(ctx)
+
+			tt.mutate(t, td)
+
+			histories := client.TodoHistory.Query().
+				Where(todohistory.Ref(td.ID)).
+				Order(generated.Asc(todohistory.FieldHistoryTime), generated.Asc(todohistory.FieldVersion)).
+				AllX(system)
+
+			require.Len(t, histories, len(tt.wantOps))
+
+			for i, h := range histories {
+				assert.Equal(t, tt.wantOps[i], h.Operation)
+				assert.Equal(t, org.ID, h.OwnerID)
+				assert.Equal(t, tt.name, h.Name)
+			}
+
+			// the snapshot of each change is recorded
+			last := histories[len(histories)-1]
+			assert.Equal(t, tt.wantVersion, last.Version)
+			assert.Equal(t, u.ID, last.UpdatedBy)
+			assert.Equal(t, tt.wantDelete, last.DeletedAt.After(td.CreatedAt))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+)
+
+// softDeleteMutation is implemented by the mutations of schemas using the soft delete mixin
+type softDeleteMutation interface {
+	ent.Mutation
+	SetOp(ent.Op)
+	Client() *generated.Client
+	SetDeletedAt(time.Time)
+	SetDeletedBy(string)
+	WhereP(...func(*sql.Selector))
+}
+
+// HookSoftDelete turns deletes into updates setting the deleted_at and deleted_by fields, objects
+// are only removed when the context skips soft deletes, e.g. when purging
+func HookSoftDelete(field string) ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if entx.CheckSkipSoftDelete(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			sd, ok := m.(softDeleteMutation)
+			if !ok {
+				return nil, fmt.Errorf("%w: %T", ErrUnexpectedSoftDeleteMutation, m)
+			}
+
+			// objects that are already deleted can not be deleted again
+			sd.WhereP(sql.FieldIsNull(field))
+			sd.SetOp(ent.OpUpdate)
+
+			sd.SetDeletedAt(time.Now())
+			sd.SetDeletedBy(ActorFromContext(ctx))
+
+			// let the other hooks know this update is a soft delete
+			return sd.Client().Mutate(entx.IsSoftDelete(ctx), sd)
+		})
+	}, ent.OpDelete|ent.OpDeleteOne)
+}
@@ -0,0 +1,139 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// todoStatusTransitions holds the statuses a todo is allowed to move to from each status,
+// keeping the same status is always allowed
+var todoStatusTransitions = map[todo.Status][]todo.Status{
+	todo.StatusOPEN:        {todo.StatusIN_PROGRESS, todo.StatusBLOCKED, todo.StatusDONE, todo.StatusCANCELED},
+	todo.StatusIN_PROGRESS: {todo.StatusOPEN, todo.StatusBLOCKED, todo.StatusDONE, todo.StatusCANCELED},
+	todo.StatusBLOCKED:     {todo.StatusOPEN, todo.StatusIN_PROGRESS, todo.StatusCANCELED},
+	todo.StatusDONE:        {todo.StatusOPEN},
+	todo.StatusCANCELED:    {todo.StatusOPEN},
+}
+
+// StatusTransitionError is returned when a todo is moved to a status that is not allowed from its current status
+type StatusTransitionError struct {
+	From todo.Status
+	To   todo.Status
+}
+
+// Error returns the StatusTransitionError in string format
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s to %s", ErrInvalidStatusTransition, e.From, e.To)
+}
+
+// Unwrap returns ErrInvalidStatusTransition
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrInvalidStatusTransition
+}
+
+// canTransition returns true when a todo is allowed to move from one status to the other
+func canTransition(from, to todo.Status) bool {
+	if from == to {
+		return true
+	}
+
+	for _, s := range todoStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HookTodoStatus enforces the allowed status transitions of a todo and sets the completed time
+// when the todo is done; the completed time is cleared when the todo is moved out of DONE
+func HookTodoStatus() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *generated.TodoMutation) (generated.Value, error) {
+			status, ok := m.Status()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// new todos can start in any status
+			if m.Op().Is(ent.OpCreate) {
+				if status == todo.StatusDONE {
+					m.SetCompletedAt(time.Now())
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			current, err := currentStatuses(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			changed := false
+
+			for _, from := range current {
+				if !canTransition(from, status) {
+					return nil, &StatusTransitionError{From: from, To: status}
+				}
+
+				if from != status {
+					changed = true
+				}
+			}
+
+			// keep the original completed time when the status is not changed
+			if changed {
+				if status == todo.StatusDONE {
+					m.SetCompletedAt(time.Now())
+				} else {
+					m.ClearCompletedAt()
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// currentStatuses returns the distinct statuses of the todos matched by the mutation before it is applied
+func currentStatuses(ctx context.Context, m *generated.TodoMutation) ([]todo.Status, error) {
+	if m.Op().Is(ent.OpUpdateOne) {
+		status, err := m.OldStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []todo.Status{status}, nil
+	}
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// restored todos are matched by the mutation while still soft deleted
+	values, err := m.Client().Todo.Query().
+		Where(todo.IDIn(ids...)).
+		Unique(true).
+		Select(todo.FieldStatus).
+		Strings(interceptors.WithDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]todo.Status, 0, len(values))
+	for _, v := range values {
+		statuses = append(statuses, todo.Status(v))
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,151 @@
+package hooks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestHookTodoStatus(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	u := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	ctx := testutils.UserContext(u.ID, org.ID)
+
+	tests := []struct {
+		name          string
+		from          todo.Status
+		to            todo.Status
+		wantErr       bool
+		wantCompleted bool
+	}{
+		{
+			name: "open to in progress",
+			from: todo.StatusOPEN,
+			to:   todo.StatusIN_PROGRESS,
+		},
+		{
+			name:          "in progress to done",
+			from:          todo.StatusIN_PROGRESS,
+			to:            todo.StatusDONE,
+			wantCompleted: true,
+		},
+		{
+			name:          "done to done keeps the completed time",
+			from:          todo.StatusDONE,
+			to:            todo.StatusDONE,
+			wantCompleted: true,
+		},
+		{
+			name: "done to open clears the completed time",
+			from: todo.StatusDONE,
+			to:   todo.StatusOPEN,
+		},
+		{
+			name:          "done to in progress",
+			from:          todo.StatusDONE,
+			to:            todo.StatusIN_PROGRESS,
+			wantErr:       true,
+			wantCompleted: true,
+		},
+		{
+			name:    "blocked to done",
+			from:    todo.StatusBLOCKED,
+			to:      todo.StatusDONE,
+			wantErr: true,
+		},
+		{
+			name:    "canceled to blocked",
+			from:    todo.StatusCANCELED,
+			to:      todo.StatusBLOCKED,
+			wantErr: true,
+		},
+		{
+			name: "canceled to open",
+			from: todo.StatusCANCELED,
+			to:   todo.StatusOPEN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// new todos can start in any status
+			td := client.Todo.Create().SetName(tt.name).SetStatus(tt.from).SaveX(ctx)
+			assert.Equal(t, tt.from == todo.StatusDONE, td.CompletedAt != nil)
+
+			err := client.Todo.UpdateOne(td).SetStatus(tt.to).Exec(ctx)
+
+			got := client.Todo.GetX(system, td.ID)
+
+			if tt.wantErr {
+				var transitionErr *hooks.StatusTransitionError
+
+				require.ErrorAs(t, err, &transitionErr)
+				assert.ErrorIs(t, err, hooks.ErrInvalidStatusTransition)
+				assert.Equal(t, tt.from, transitionErr.From)
+				assert.Equal(t, tt.to, transitionErr.To)
+				assert.Equal(t, tt.from, got.Status)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.to, got.Status)
+			}
+
+			if !tt.wantCompleted {
+				assert.Nil(t, got.CompletedAt)
+
+				return
+			}
+
+			require.NotNil(t, got.CompletedAt)
+
+			// the completed time is only set when the todo moves to done
+			if tt.from == todo.StatusDONE {
+				assert.True(t, got.CompletedAt.Equal(*td.CompletedAt))
+			}
+		})
+	}
+
+	t.Run("bulk update", func(t *testing.T) {
+		open := client.Todo.Create().SetName("bulk open").SaveX(ctx)
+		blocked := client.Todo.Create().SetName("bulk blocked").SetStatus(todo.StatusBLOCKED).SaveX(ctx)
+
+		// a single todo that can not move to the status rejects the whole update
+		err := client.Todo.Update().Where(todo.IDIn(open.ID, blocked.ID)).SetStatus(todo.StatusDONE).Exec(ctx)
+		require.ErrorIs(t, err, hooks.ErrInvalidStatusTransition)
+
+		assert.Equal(t, todo.StatusOPEN, client.Todo.GetX(system, open.ID).Status)
+
+		before := time.Now().Add(-time.Second)
+
+		require.NoError(t, client.Todo.Update().Where(todo.IDIn(open.ID, blocked.ID)).SetStatus(todo.StatusCANCELED).Exec(ctx))
+
+		for _, id := range []string{open.ID, blocked.ID} {
+			got := client.Todo.GetX(system, id)
+			assert.Equal(t, todo.StatusCANCELED, got.Status)
+			assert.Nil(t, got.CompletedAt)
+		}
+
+		require.NoError(t, client.Todo.Update().Where(todo.IDIn(open.ID, blocked.ID)).SetStatus(todo.StatusOPEN).Exec(ctx))
+		require.NoError(t, client.Todo.Update().Where(todo.IDIn(open.ID, blocked.ID)).SetStatus(todo.StatusDONE).Exec(ctx))
+
+		for _, id := range []string{open.ID, blocked.ID} {
+			got := client.Todo.GetX(system, id)
+			require.NotNil(t, got.CompletedAt)
+			assert.True(t, got.CompletedAt.After(before))
+		}
+	})
+}
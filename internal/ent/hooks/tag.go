@@ -0,0 +1,40 @@
+package hooks
+
+import (
+	"context"
+	"slices"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+)
+
+// HookTodoTags checks the tags added to a todo exist, the tag query is scoped to the organization
+// in the auth context so the tags of other organizations can not be added
+func HookTodoTags() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *generated.TodoMutation) (generated.Value, error) {
+			tagIDs := m.TagsIDs()
+			if len(tagIDs) == 0 {
+				return next.Mutate(ctx, m)
+			}
+
+			// the matching tags are counted, so a tag added more than once must only be counted once
+			slices.Sort(tagIDs)
+			tagIDs = slices.Compact(tagIDs)
+
+			count, err := m.Client().Tag.Query().Where(tag.IDIn(tagIDs...)).Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if count != len(tagIDs) {
+				return nil, ErrTagNotFound
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
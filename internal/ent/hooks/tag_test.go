@@ -0,0 +1,85 @@
+package hooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestHookTodoTags(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	otherOrg := client.Organization.Create().SetName("globex").SaveX(system)
+
+	owner := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	outsider := client.User.Create().SetEmail("owner@globex.com").SaveX(system)
+
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(otherOrg.ID).SetUserID(outsider.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	ctx := testutils.UserContext(owner.ID, org.ID)
+
+	urgent := client.Tag.Create().SetName("urgent").SaveX(ctx)
+	home := client.Tag.Create().SetName("home").SaveX(ctx)
+	otherTag := client.Tag.Create().SetName("urgent").SaveX(testutils.UserContext(outsider.ID, otherOrg.ID))
+
+	tests := []struct {
+		name     string
+		tagIDs   []string
+		wantErr  error
+		wantTags []string
+	}{
+		{
+			name:     "tags of the organization",
+			tagIDs:   []string{urgent.ID, home.ID},
+			wantTags: []string{urgent.ID, home.ID},
+		},
+		{
+			name:     "tag added more than once",
+			tagIDs:   []string{urgent.ID, urgent.ID, home.ID},
+			wantTags: []string{urgent.ID, home.ID},
+		},
+		{
+			name:    "tag of another organization",
+			tagIDs:  []string{urgent.ID, otherTag.ID},
+			wantErr: hooks.ErrTagNotFound,
+		},
+		{
+			name:    "tag that does not exist",
+			tagIDs:  []string{"tag_01HZX3Q9Y8J1V5R2M6N7K4P0TS"},
+			wantErr: hooks.ErrTagNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the tags are checked when the todo is created and when they are added to an existing todo
+			_, err := client.Todo.Create().SetName("create " + tt.name).AddTagIDs(tt.tagIDs...).Save(ctx)
+
+			td := client.Todo.Create().SetName(tt.name).SaveX(ctx)
+			updateErr := client.Todo.UpdateOne(td).AddTagIDs(tt.tagIDs...).Exec(ctx)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, updateErr, tt.wantErr)
+				assert.Empty(t, td.QueryTags().IDsX(system))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, updateErr)
+			assert.ElementsMatch(t, tt.wantTags, td.QueryTags().IDsX(system))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// tenantMutation is implemented by the mutations of schemas using the org owned mixin
+type tenantMutation interface {
+	ent.Mutation
+	WhereP(...func(*sql.Selector))
+}
+
+// HookTenant sets the organization in the auth context as the owner of created objects, where the
+// field holds the id of the owning organization, and scopes updates and deletes to the objects it owns
+func HookTenant(field string) ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if interceptors.CheckSkipTenant(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			tm, ok := m.(tenantMutation)
+			if !ok {
+				return nil, fmt.Errorf("%w: %T", ErrUnexpectedTenantMutation, m)
+			}
+
+			orgID, err := interceptors.TenantFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				if err := tm.SetField(field, orgID); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, tm)
+			}
+
+			tm.WhereP(sql.FieldEQ(field, orgID))
+
+			return next.Mutate(ctx, tm)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
@@ -0,0 +1,145 @@
+package hooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestHookTenant(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	otherOrg := client.Organization.Create().SetName("globex").SaveX(system)
+
+	owner := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	outsider := client.User.Create().SetEmail("owner@globex.com").SaveX(system)
+
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(otherOrg.ID).SetUserID(outsider.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	ctx := testutils.UserContext(owner.ID, org.ID)
+	otherCtx := testutils.UserContext(outsider.ID, otherOrg.ID)
+
+	tests := []struct {
+		name         string
+		mutate       func(t *testing.T, tg *generated.Tag) error
+		wantErr      error
+		wantNotFound bool
+		wantName     string
+		wantExists   bool
+	}{
+		{
+			name: "update in the organization",
+			mutate: func(_ *testing.T, tg *generated.Tag) error {
+				return client.Tag.UpdateOneID(tg.ID).SetName("changed").Exec(ctx)
+			},
+			wantName:   "changed",
+			wantExists: true,
+		},
+		{
+			name: "update in another organization",
+			mutate: func(_ *testing.T, tg *generated.Tag) error {
+				return client.Tag.UpdateOneID(tg.ID).SetName("changed").Exec(otherCtx)
+			},
+			wantNotFound: true,
+			wantExists:   true,
+		},
+		{
+			name: "bulk update in another organization",
+			mutate: func(t *testing.T, _ *generated.Tag) error {
+				n, err := client.Tag.Update().SetName("changed").Save(otherCtx)
+				assert.Equal(t, 0, n)
+
+				return err
+			},
+			wantExists: true,
+		},
+		{
+			name: "delete in the organization",
+			mutate: func(_ *testing.T, tg *generated.Tag) error {
+				return client.Tag.DeleteOneID(tg.ID).Exec(ctx)
+			},
+		},
+		{
+			name: "delete in another organization",
+			mutate: func(_ *testing.T, tg *generated.Tag) error {
+				return client.Tag.DeleteOneID(tg.ID).Exec(otherCtx)
+			},
+			wantNotFound: true,
+			wantExists:   true,
+		},
+		{
+			name: "bulk delete in another organization",
+			mutate: func(t *testing.T, _ *generated.Tag) error {
+				n, err := client.Tag.Delete().Exec(otherCtx)
+				assert.Equal(t, 0, n)
+
+				return err
+			},
+			wantExists: true,
+		},
+		{
+			name: "update without an organization",
+			mutate: func(_ *testing.T, tg *generated.Tag) error {
+				return client.Tag.UpdateOneID(tg.ID).SetName("changed").Exec(testutils.UserContext(owner.ID, ""))
+			},
+			wantErr:    interceptors.ErrMissingTenant,
+			wantExists: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the owner is set from the organization in the auth context
+			tg := client.Tag.Create().SetName(tt.name).SaveX(ctx)
+			assert.Equal(t, org.ID, tg.OwnerID)
+
+			err := tt.mutate(t, tg)
+			switch {
+			case tt.wantNotFound:
+				assert.True(t, generated.IsNotFound(err), err)
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			default:
+				require.NoError(t, err)
+			}
+
+			got, err := client.Tag.Query().Where(tag.ID(tg.ID)).Only(system)
+			if !tt.wantExists {
+				assert.True(t, generated.IsNotFound(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, org.ID, got.OwnerID)
+
+			if tt.wantName != "" {
+				assert.Equal(t, tt.wantName, got.Name)
+			} else {
+				assert.Equal(t, tt.name, got.Name)
+			}
+		})
+	}
+
+	t.Run("create without an organization", func(t *testing.T) {
+		_, err := client.Tag.Create().SetName("no organization").Save(testutils.UserContext(owner.ID, ""))
+		assert.ErrorIs(t, err, interceptors.ErrMissingTenant)
+	})
+
+	t.Run("create in a system context", func(t *testing.T) {
+		tg := client.Tag.Create().SetName("system").SetOwnerID(otherOrg.ID).SaveX(system)
+		assert.Equal(t, otherOrg.ID, tg.OwnerID)
+	})
+}
@@ -0,0 +1,167 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// Topics the todo lifecycle events are published to
+const (
+	// TopicTodoCreated receives the events of the created todos
+	TopicTodoCreated = "todo.created"
+	// TopicTodoUpdated receives the events of the updated todos
+	TopicTodoUpdated = "todo.updated"
+	// TopicTodoDeleted receives the events of the deleted todos
+	TopicTodoDeleted = "todo.deleted"
+)
+
+// Operations of the todo lifecycle events
+const (
+	OpTodoCreated = "created"
+	OpTodoUpdated = "updated"
+	OpTodoDeleted = "deleted"
+)
+
+// TodoEvent is the payload of the todo lifecycle events, it only holds the id of the todo so the
+// subscribers load the todo themselves with their own privacy and tenant rules applied
+type TodoEvent struct {
+	// ID is the id of the todo
+	ID string `json:"id"`
+	// Op is the operation of the event, e.g. created
+	Op string `json:"op"`
+}
+
+// TenantTopic returns the topic the events of the organization are published to, subscribers only
+// receive the events of their own organization; objects without an owner are published to the topic
+func TenantTopic(topic, orgID string) string {
+	if orgID == "" {
+		return topic
+	}
+
+	return topic + "." + orgID
+}
+
+// event is a single message to publish to the event broker
+type event struct {
+	topic   string
+	payload TodoEvent
+}
+
+// todoEvent returns the event of the todo owned by the organization for the operation
+func todoEvent(topic, op, id, ownerID string) event {
+	return event{topic: TenantTopic(topic, ownerID), payload: TodoEvent{ID: id, Op: op}}
+}
+
+// HookTodoEvents publishes the todo lifecycle events to the event broker after the mutation is committed
+func HookTodoEvents() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *generated.TodoMutation) (generated.Value, error) {
+			// events are not enabled, nothing to do
+			if m.Events == nil {
+				return next.Mutate(ctx, m)
+			}
+
+			// get the ids and owners before the mutation, deleted objects can't be queried afterwards
+			var owners map[string]string
+
+			if m.Op().Is(ent.OpDelete | ent.OpDeleteOne | ent.OpUpdate) {
+				var err error
+
+				owners, err = todoOwners(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			var events []event
+
+			switch {
+			case entx.CheckIsSoftDelete(ctx):
+				for id, owner := range owners {
+					events = append(events, todoEvent(TopicTodoDeleted, OpTodoDeleted, id, owner))
+				}
+			case m.Op().Is(ent.OpCreate | ent.OpUpdateOne):
+				t, ok := v.(*generated.Todo)
+				if !ok {
+					return nil, fmt.Errorf("%w: %T", ErrUnexpectedMutationValue, v)
+				}
+
+				if m.Op().Is(ent.OpCreate) {
+					events = append(events, todoEvent(TopicTodoCreated, OpTodoCreated, t.ID, t.OwnerID))
+				} else {
+					events = append(events, todoEvent(TopicTodoUpdated, OpTodoUpdated, t.ID, t.OwnerID))
+				}
+			case m.Op().Is(ent.OpUpdate):
+				for id, owner := range owners {
+					events = append(events, todoEvent(TopicTodoUpdated, OpTodoUpdated, id, owner))
+				}
+			default:
+				for id, owner := range owners {
+					events = append(events, todoEvent(TopicTodoDeleted, OpTodoDeleted, id, owner))
+				}
+			}
+
+			publishOnCommit(ctx, m, events)
+
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// todoOwners returns the owning organization of each todo matched by the mutation
+func todoOwners(ctx context.Context, m *generated.TodoMutation) (map[string]string, error) {
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// restored todos are matched by the mutation while still soft deleted
+	todos, err := m.Client().Todo.Query().
+		Where(todo.IDIn(ids...)).
+		Select(todo.FieldID, todo.FieldOwnerID).
+		All(interceptors.WithDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]string, len(todos))
+	for _, t := range todos {
+		owners[t.ID] = t.OwnerID
+	}
+
+	return owners, nil
+}
+
+// publishOnCommit publishes the events once the transaction of the mutation is committed,
+// or right away when the mutation is not running in a transaction
+func publishOnCommit(ctx context.Context, m *generated.TodoMutation, events []event) {
+	runOnCommit(ctx, m, func(ctx context.Context) {
+		for _, e := range events {
+			payload, err := json.Marshal(e.payload)
+			if err != nil {
+				m.Logger.Errorw("unable to marshal event", "topic", e.topic, "error", err)
+
+				continue
+			}
+
+			// the request may already be done, do not cancel the publish with it
+			if err := m.Events.Publish(context.WithoutCancel(ctx), e.topic, payload); err != nil {
+				m.Logger.Errorw("unable to publish event", "topic", e.topic, "error", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/passwd"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+)
+
+// HookUserPassword checks the strength of the password set on a user and stores its derived key
+// instead, the password itself is never written to the database
+func HookUserPassword() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *generated.UserMutation) (generated.Value, error) {
+			password, ok := m.Password()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			if passwd.Strength(password) < passwd.Moderate {
+				return nil, auth.ErrPasswordTooWeak
+			}
+
+			dk, err := passwd.CreateDerivedKey(password)
+			if err != nil {
+				return nil, err
+			}
+
+			m.SetPassword(dk)
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
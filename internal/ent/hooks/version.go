@@ -0,0 +1,108 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/hook"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+)
+
+// expectedVersionCtxKey is the context key set by WithExpectedVersion
+type expectedVersionCtxKey struct{}
+
+// WithExpectedVersion returns a new context with the version a client expects the todos it changes to be at,
+// updates and deletes of todos that have changed since fail with a VersionConflictError
+func WithExpectedVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, expectedVersionCtxKey{}, version)
+}
+
+// ExpectedVersionFromContext returns the expected version set on the context, if any
+func ExpectedVersionFromContext(ctx context.Context) (int, bool) {
+	version, ok := ctx.Value(expectedVersionCtxKey{}).(int)
+
+	return version, ok
+}
+
+// VersionConflictError is returned when a todo is changed based on a version that is no longer current
+type VersionConflictError struct {
+	Expected int
+	Current  int
+}
+
+// Error returns the VersionConflictError in string format
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s: expected version %d, current version is %d", ErrVersionConflict, e.Expected, e.Current)
+}
+
+// Unwrap returns ErrVersionConflict
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// HookTodoVersion increments the version of a todo on every update, soft deletes included; when the context
+// has an expected version the mutation is rejected if any of the matched todos is at a different version
+func HookTodoVersion() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *generated.TodoMutation) (generated.Value, error) {
+			m.AddVersion(1)
+
+			expected, ok := ExpectedVersionFromContext(ctx)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			current, err := currentVersions(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range current {
+				if v != expected {
+					return nil, &VersionConflictError{Expected: expected, Current: v}
+				}
+			}
+
+			// guard against changes committed between reading the versions and the update
+			m.Where(todo.Version(expected))
+
+			v, err := next.Mutate(ctx, m)
+			if len(current) == 0 {
+				return v, err
+			}
+
+			// the todos existed but none of them matched the guard, so they were changed concurrently
+			if n, ok := v.(int); generated.IsNotFound(err) || (err == nil && ok && n == 0) {
+				return nil, ErrVersionConflict
+			}
+
+			return v, err
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// currentVersions returns the distinct versions of the todos matched by the mutation before it is applied
+func currentVersions(ctx context.Context, m *generated.TodoMutation) ([]int, error) {
+	if m.Op().Is(ent.OpUpdateOne) {
+		version, err := m.OldVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []int{version}, nil
+	}
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Client().Todo.Query().
+		Where(todo.IDIn(ids...)).
+		Unique(true).
+		Select(todo.FieldVersion).
+		Ints(ctx)
+}
@@ -0,0 +1,2 @@
+// Package ids generates and validates the prefixed IDs of the ent schemas
+package ids
@@ -0,0 +1,60 @@
+package ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+const (
+	// Separator separates the type prefix from the ulid of an ID, e.g. todo_01H...
+	Separator = "_"
+
+	// OrganizationPrefix is the prefix of the IDs of organizations
+	OrganizationPrefix = "org"
+	// UserPrefix is the prefix of the IDs of users
+	UserPrefix = "user"
+	// OrgMembershipPrefix is the prefix of the IDs of organization memberships
+	OrgMembershipPrefix = "orgmembership"
+	// TagPrefix is the prefix of the IDs of tags
+	TagPrefix = "tag"
+	// TodoPrefix is the prefix of the IDs of todos
+	TodoPrefix = "todo"
+	// TodoHistoryPrefix is the prefix of the IDs of the todo history
+	TodoHistoryPrefix = "todohistory"
+)
+
+// ErrInvalidID is returned when an ID does not contain the expected prefix and a valid ulid
+var ErrInvalidID = errors.New("invalid id")
+
+// New returns a new ulid with the prefix
+func New(prefix string) string {
+	return prefix + Separator + ulids.New().String()
+}
+
+// Validate returns a validator that checks the ID contains the prefix followed by a valid ulid
+func Validate(prefix string) func(string) error {
+	return func(id string) error {
+		p, uid, ok := strings.Cut(id, Separator)
+		if !ok || p != prefix {
+			return fmt.Errorf("%w: %s does not have the %s prefix", ErrInvalidID, id, prefix)
+		}
+
+		if _, err := ulid.ParseStrict(uid); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidID, id, err)
+		}
+
+		return nil
+	}
+}
+
+// Prefix returns the type prefix of the ID
+func Prefix(id string) (string, bool) {
+	prefix, _, ok := strings.Cut(id, Separator)
+
+	return prefix, ok
+}
@@ -0,0 +1,121 @@
+package ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	first := New(TodoPrefix)
+	second := New(TodoPrefix)
+
+	assert.True(t, strings.HasPrefix(first, TodoPrefix+Separator))
+	assert.NotEqual(t, first, second)
+
+	require.NoError(t, Validate(TodoPrefix)(first))
+	require.NoError(t, Validate(TodoPrefix)(second))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		id      string
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			prefix: TodoPrefix,
+			id:     "todo_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+		},
+		{
+			name:   "prefix containing the type of another schema",
+			prefix: TodoHistoryPrefix,
+			id:     "todohistory_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+		},
+		{
+			name:    "prefix of another type",
+			prefix:  TodoPrefix,
+			id:      "tag_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantErr: true,
+		},
+		{
+			name:    "without a prefix",
+			prefix:  TodoPrefix,
+			id:      "01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantErr: true,
+		},
+		{
+			name:    "invalid ulid",
+			prefix:  TodoPrefix,
+			id:      "todo_01HZX3Q9",
+			wantErr: true,
+		},
+		{
+			name:    "ulid with invalid characters",
+			prefix:  TodoPrefix,
+			id:      "todo_01HZX3Q9Y8J1V5R2M6N7K4P0TU",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			prefix:  TodoPrefix,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.prefix)(tt.id)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidID)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantPrefix string
+		wantOK     bool
+	}{
+		{
+			name:       "prefixed id",
+			id:         "org_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantPrefix: OrganizationPrefix,
+			wantOK:     true,
+		},
+		{
+			name:       "unknown prefix",
+			id:         "invoice_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantPrefix: "invoice",
+			wantOK:     true,
+		},
+		{
+			name:   "without a separator",
+			id:     "01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, ok := Prefix(tt.id)
+
+			assert.Equal(t, tt.wantOK, ok)
+
+			if tt.wantOK {
+				assert.Equal(t, tt.wantPrefix, prefix)
+			}
+		})
+	}
+}
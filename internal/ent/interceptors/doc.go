@@ -0,0 +1,2 @@
+// Package interceptors contains the ent interceptors used by the ent schema
+package interceptors
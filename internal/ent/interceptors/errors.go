@@ -0,0 +1,10 @@
+package interceptors
+
+import "errors"
+
+var (
+	// ErrMissingTenant is returned when the organization of the request can not be found in the auth context
+	ErrMissingTenant = errors.New("organization not found in the auth context")
+	// ErrMissingUser is returned when the user of the request can not be found in the auth context
+	ErrMissingUser = errors.New("user not found in the auth context")
+)
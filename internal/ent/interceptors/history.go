@@ -0,0 +1,83 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/fgax"
+
+	"github.com/datumforge/datum/pkg/auth"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/intercept"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+)
+
+// HistoryAccess scopes the queries of a history schema, it is added to the history schemas generated by enthistory.
+// The viewer must have the relation with the organization in the auth context in openFGA, and the history of org
+// owned schemas is scoped to that organization the same as the schema itself; user owned history is scoped to the
+// viewer. enthistory only detects org owned schemas from their fga annotation, the schemas here write their tuples
+// in hooks so the history of the org owned schemas is also known by its type. Queries in a system context are not
+// checked
+func HistoryAccess(relation string, orgOwned, userOwned bool) ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if CheckSkipTenant(ctx) {
+			return nil
+		}
+
+		orgID, err := TenantFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		userID, err := UserFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		c, ownedByOrg := historyScope(q)
+
+		if c.Ofga != nil {
+			allowed, err := c.CheckAccess(ctx, fgax.AccessCheck{
+				ObjectType:  "organization",
+				ObjectID:    orgID,
+				SubjectID:   userID,
+				SubjectType: auth.GetAuthzSubjectType(ctx),
+				Relation:    relation,
+			})
+			if err != nil {
+				return fmt.Errorf("%w: unable to check access: %w", privacy.Deny, err)
+			}
+
+			if !allowed {
+				return privacy.Denyf("viewer does not have the %s relation with the organization", relation)
+			}
+		}
+
+		query, err := intercept.NewQuery(q)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case orgOwned || ownedByOrg:
+			query.WhereP(sql.FieldEQ("owner_id", orgID))
+		case userOwned:
+			query.WhereP(sql.FieldEQ("owner_id", userID))
+		}
+
+		return nil
+	})
+}
+
+// historyScope returns the openFGA client of the history query and whether the history is of an org owned schema
+func historyScope(q ent.Query) (fgax.Client, bool) {
+	switch q := q.(type) {
+	case *generated.TodoHistoryQuery:
+		return q.Authz, true
+	default:
+		return fgax.Client{}, false
+	}
+}
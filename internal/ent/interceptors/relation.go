@@ -0,0 +1,69 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"github.com/datumforge/fgax"
+
+	"github.com/datumforge/datum/pkg/auth"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/intercept"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+)
+
+// InterceptorTodoRelation scopes all todo queries to the todos the viewer has the can_view relation with in openFGA.
+// The ids of those todos are listed before the query runs and added as a predicate, so pagination, counts, exists
+// and search are scoped the same way as a single todo. Queries in a system context and queries of clients without
+// authorization enabled are not checked
+func InterceptorTodoRelation(objectType fgax.Kind) ent.Interceptor {
+	return intercept.TraverseTodo(func(ctx context.Context, q *generated.TodoQuery) error {
+		if q.Authz.Ofga == nil || CheckSkipTenant(ctx) {
+			return nil
+		}
+
+		userID, err := UserFromContext(ctx)
+		if err != nil {
+			return privacy.Denyf("anonymous viewer")
+		}
+
+		ids, err := listObjectIDs(ctx, q.Authz, objectType, userID)
+		if err != nil {
+			return err
+		}
+
+		q.Where(todo.IDIn(ids...))
+
+		return nil
+	})
+}
+
+// listObjectIDs returns the ids of the objects of the type the viewer has the can_view relation with, the request
+// is denied when openFGA can not be reached
+func listObjectIDs(ctx context.Context, c fgax.Client, objectType fgax.Kind, userID string) ([]string, error) {
+	resp, err := c.ListObjectsRequest(ctx, fgax.ListRequest{
+		SubjectID:   userID,
+		SubjectType: auth.GetAuthzSubjectType(ctx),
+		Relation:    fgax.CanView,
+		ObjectType:  objectType.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to list objects: %w", privacy.Deny, err)
+	}
+
+	ids := make([]string, 0, len(resp.GetObjects()))
+
+	for _, o := range resp.GetObjects() {
+		e, err := fgax.ParseEntity(o)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to list objects: %w", privacy.Deny, err)
+		}
+
+		ids = append(ids, e.Identifier)
+	}
+
+	return ids, nil
+}
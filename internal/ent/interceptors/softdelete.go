@@ -0,0 +1,43 @@
+package interceptors
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/generated/intercept"
+)
+
+// includeDeletedCtxKey is the context key set by WithDeleted
+type includeDeletedCtxKey struct{}
+
+// WithDeleted returns a new context that includes soft deleted objects in query results,
+// unlike entx.SkipSoftDelete, deletes made with this context are still soft deletes
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedCtxKey{}, true)
+}
+
+// IncludeDeleted returns true when soft deleted objects should be included in query results
+func IncludeDeleted(ctx context.Context) bool {
+	if include, _ := ctx.Value(includeDeletedCtxKey{}).(bool); include {
+		return true
+	}
+
+	return entx.CheckSkipSoftDelete(ctx)
+}
+
+// InterceptorSoftDelete filters out the soft deleted objects, where the field is set, from all queries
+// unless the context includes deleted objects
+func InterceptorSoftDelete(field string) ent.Interceptor {
+	return intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+		if IncludeDeleted(ctx) {
+			return nil
+		}
+
+		q.WhereP(sql.FieldIsNull(field))
+
+		return nil
+	})
+}
@@ -0,0 +1,119 @@
+package interceptors
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/datumforge/datum/pkg/auth"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/intercept"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+)
+
+// skipTenantCtxKey is the context key set by SkipTenant
+type skipTenantCtxKey struct{}
+
+// SkipTenant returns a new context that is not scoped to an organization, it is used by the CLI
+// and background jobs which work across all organizations, e.g. when purging
+func SkipTenant(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipTenantCtxKey{}, true)
+}
+
+// CheckSkipTenant returns true when the context is not scoped to an organization
+func CheckSkipTenant(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipTenantCtxKey{}).(bool)
+
+	return skip
+}
+
+// TenantFromContext returns the id of the organization in the auth context; the authenticated user is
+// read directly as the auth helpers only accept bare ulids, while the ids of organizations are prefixed
+func TenantFromContext(ctx context.Context) (string, error) {
+	au, err := auth.GetAuthenticatedUserContext(ctx)
+	if err != nil || au.OrganizationID == "" {
+		return "", ErrMissingTenant
+	}
+
+	return au.OrganizationID, nil
+}
+
+// UserFromContext returns the id of the user in the auth context, read the same way as the tenant
+func UserFromContext(ctx context.Context) (string, error) {
+	au, err := auth.GetAuthenticatedUserContext(ctx)
+	if err != nil || au.SubjectID == "" {
+		return "", ErrMissingUser
+	}
+
+	return au.SubjectID, nil
+}
+
+// InterceptorTenant scopes all queries to the objects owned by the organization in the auth context,
+// where the field holds the id of the owning organization
+func InterceptorTenant(field string) ent.Interceptor {
+	return intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+		if CheckSkipTenant(ctx) {
+			return nil
+		}
+
+		orgID, err := TenantFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		q.WhereP(sql.FieldEQ(field, orgID))
+
+		return nil
+	})
+}
+
+// InterceptorOrganization scopes organization queries to the organizations the user in the auth context is a member of
+func InterceptorOrganization() ent.Interceptor {
+	return intercept.TraverseOrganization(func(ctx context.Context, q *generated.OrganizationQuery) error {
+		if CheckSkipTenant(ctx) {
+			return nil
+		}
+
+		userID, err := UserFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		q.Where(organization.HasMembersWith(orgmembership.UserID(userID)))
+
+		return nil
+	})
+}
+
+// InterceptorUser scopes user queries to the user in the auth context and the members of its organization
+func InterceptorUser() ent.Interceptor {
+	return intercept.TraverseUser(func(ctx context.Context, q *generated.UserQuery) error {
+		if CheckSkipTenant(ctx) {
+			return nil
+		}
+
+		userID, err := UserFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		// without an organization only the user itself can be queried
+		orgID, err := TenantFromContext(ctx)
+		if err != nil {
+			q.Where(user.ID(userID))
+
+			return nil
+		}
+
+		q.Where(user.Or(
+			user.ID(userID),
+			user.HasMembershipsWith(orgmembership.OrganizationID(orgID)),
+		))
+
+		return nil
+	})
+}
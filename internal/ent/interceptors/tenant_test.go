@@ -0,0 +1,166 @@
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+func TestFromContext(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		wantTenant    string
+		wantTenantErr error
+		wantUser      string
+		wantUserErr   error
+	}{
+		{
+			name:       "user in an organization",
+			ctx:        testutils.UserContext("user_01", "org_01"),
+			wantTenant: "org_01",
+			wantUser:   "user_01",
+		},
+		{
+			name:          "user without an organization",
+			ctx:           testutils.UserContext("user_01", ""),
+			wantTenantErr: interceptors.ErrMissingTenant,
+			wantUser:      "user_01",
+		},
+		{
+			name:          "not authenticated",
+			ctx:           context.Background(),
+			wantTenantErr: interceptors.ErrMissingTenant,
+			wantUserErr:   interceptors.ErrMissingUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, err := interceptors.TenantFromContext(tt.ctx)
+			if tt.wantTenantErr != nil {
+				assert.ErrorIs(t, err, tt.wantTenantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantTenant, tenant)
+			}
+
+			userID, err := interceptors.UserFromContext(tt.ctx)
+			if tt.wantUserErr != nil {
+				assert.ErrorIs(t, err, tt.wantUserErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantUser, userID)
+			}
+		})
+	}
+}
+
+func TestTenantInterceptors(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	otherOrg := client.Organization.Create().SetName("globex").SaveX(system)
+	client.Organization.Create().SetName("initech").SaveX(system)
+
+	owner := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	member := client.User.Create().SetEmail("member@acme.com").SaveX(system)
+	outsider := client.User.Create().SetEmail("owner@globex.com").SaveX(system)
+
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(otherOrg.ID).SetUserID(outsider.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+	// the owner is also a member of the other organization
+	client.OrgMembership.Create().SetOrganizationID(otherOrg.ID).SetUserID(owner.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+
+	tag := client.Tag.Create().SetName("urgent").SaveX(testutils.UserContext(owner.ID, org.ID))
+	otherTag := client.Tag.Create().SetName("urgent").SaveX(testutils.UserContext(outsider.ID, otherOrg.ID))
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantTags  []string
+		wantOrgs  []string
+		wantUsers []string
+		wantErr   error
+	}{
+		{
+			name:      "owner in the organization",
+			ctx:       testutils.UserContext(owner.ID, org.ID),
+			wantTags:  []string{tag.ID},
+			wantOrgs:  []string{org.ID, otherOrg.ID},
+			wantUsers: []string{owner.ID, member.ID},
+		},
+		{
+			name:      "owner in the other organization",
+			ctx:       testutils.UserContext(owner.ID, otherOrg.ID),
+			wantTags:  []string{otherTag.ID},
+			wantOrgs:  []string{org.ID, otherOrg.ID},
+			wantUsers: []string{owner.ID, outsider.ID},
+		},
+		{
+			name:      "member",
+			ctx:       testutils.UserContext(member.ID, org.ID),
+			wantTags:  []string{tag.ID},
+			wantOrgs:  []string{org.ID},
+			wantUsers: []string{owner.ID, member.ID},
+		},
+		{
+			name:    "not authenticated",
+			ctx:     context.Background(),
+			wantErr: interceptors.ErrMissingTenant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, err := client.Tag.Query().IDs(tt.ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				_, err = client.Organization.Query().IDs(tt.ctx)
+				assert.ErrorIs(t, err, interceptors.ErrMissingUser)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantTags, tags)
+
+			orgs, err := client.Organization.Query().IDs(tt.ctx)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantOrgs, orgs)
+
+			users, err := client.User.Query().IDs(tt.ctx)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantUsers, users)
+		})
+	}
+
+	t.Run("user without an organization", func(t *testing.T) {
+		ctx := testutils.UserContext(member.ID, "")
+
+		users, err := client.User.Query().IDs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{member.ID}, users)
+
+		_, err = client.Tag.Query().IDs(ctx)
+		assert.ErrorIs(t, err, interceptors.ErrMissingTenant)
+	})
+
+	t.Run("system context", func(t *testing.T) {
+		assert.Equal(t, 2, client.Tag.Query().CountX(system))
+		assert.Equal(t, 3, client.Organization.Query().CountX(system))
+		assert.Equal(t, 3, client.User.Query().CountX(system))
+	})
+}
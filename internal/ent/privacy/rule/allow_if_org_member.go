@@ -0,0 +1,102 @@
+package rule
+
+import (
+	"context"
+	"slices"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// AllowIfOrgMemberWithRole allows queries and mutations of viewers that are members of the organization
+// with one of the roles. Queries are filtered to the objects owned by such an organization; mutations are
+// checked against the membership of the viewer in the organization of the context, viewers that are not
+// a member at all are denied so the rules evaluated after it do not apply to former members
+func AllowIfOrgMemberWithRole(roles ...orgmembership.Role) privacy.QueryMutationRule {
+	type OwnerFilter interface {
+		WhereHasOwnerWith(...predicate.Organization)
+	}
+
+	filter := privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		userID, err := interceptors.UserFromContext(ctx)
+		if err != nil {
+			return privacy.Skipf("anonymous viewer")
+		}
+
+		ownerFilter, ok := f.(OwnerFilter)
+		if !ok {
+			return privacy.Denyf("unexpected filter type %T", f)
+		}
+
+		ownerFilter.WhereHasOwnerWith(organization.HasMembersWith(
+			orgmembership.UserID(userID),
+			orgmembership.RoleIn(roles...),
+		))
+
+		return privacy.Allowf("applied organization member filter")
+	})
+
+	return queryMutationRule{
+		QueryRule:    filter,
+		MutationRule: mutationRoleRule(roles),
+	}
+}
+
+// mutationRoleRule allows mutations of viewers with one of the roles in the organization of the context
+func mutationRoleRule(roles []orgmembership.Role) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m generated.Mutation) error {
+		mc, ok := m.(interface{ Client() *generated.Client })
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+
+		return checkRole(ctx, mc.Client(), roles)
+	})
+}
+
+// checkRole allows the viewer when its role in the organization of the context is one of the roles
+func checkRole(ctx context.Context, client *generated.Client, roles []orgmembership.Role) error {
+	role, err := orgRole(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(roles, role) {
+		return privacy.Allowf("organization member with role %s", role)
+	}
+
+	return privacy.Skipf("organization member without role")
+}
+
+// orgRole returns the role of the viewer in the organization of the context
+func orgRole(ctx context.Context, client *generated.Client) (orgmembership.Role, error) {
+	userID, err := interceptors.UserFromContext(ctx)
+	if err != nil {
+		return "", privacy.Skipf("anonymous viewer")
+	}
+
+	orgID, err := interceptors.TenantFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	membership, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return "", privacy.Denyf("viewer is not a member of the organization")
+		}
+
+		return "", err
+	}
+
+	return membership.Role, nil
+}
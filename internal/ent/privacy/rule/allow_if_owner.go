@@ -0,0 +1,47 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// AllowIfOwner allows queries and mutations after filtering them to the objects created by the viewer;
+// creates are skipped as there is no object to own yet and the filter would not restrict them
+func AllowIfOwner() privacy.QueryMutationRule {
+	type CreatedByFilter interface {
+		WhereCreatedBy(entql.StringP)
+	}
+
+	filter := privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		userID, err := interceptors.UserFromContext(ctx)
+		if err != nil {
+			return privacy.Skipf("anonymous viewer")
+		}
+
+		createdByFilter, ok := f.(CreatedByFilter)
+		if !ok {
+			return privacy.Denyf("unexpected filter type %T", f)
+		}
+
+		createdByFilter.WhereCreatedBy(entql.StringEQ(userID))
+
+		return privacy.Allowf("applied owner filter")
+	})
+
+	return queryMutationRule{
+		QueryRule: filter,
+		MutationRule: privacy.MutationRuleFunc(func(ctx context.Context, m generated.Mutation) error {
+			if m.Op().Is(ent.OpCreate) {
+				return privacy.Skip
+			}
+
+			return filter.EvalMutation(ctx, m)
+		}),
+	}
+}
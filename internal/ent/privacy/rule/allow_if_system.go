@@ -0,0 +1,21 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// AllowIfSystem allows queries and mutations running in a system context, contexts that are not scoped
+// to an organization are only created by the CLI and background jobs; graph requests are always scoped,
+// when authentication is disabled they run as the development user in its organization
+func AllowIfSystem() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if interceptors.CheckSkipTenant(ctx) {
+			return privacy.Allowf("system context")
+		}
+
+		return privacy.Skip
+	})
+}
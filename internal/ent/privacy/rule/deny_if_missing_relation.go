@@ -0,0 +1,79 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"github.com/datumforge/fgax"
+
+	"github.com/datumforge/datum/pkg/auth"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// DenyIfMissingRelation checks the access of the viewer to a single object in openFGA: updates and deletes of
+// the object by id need the can_edit and can_delete relations. Creates and bulk changes are skipped, they are scoped
+// by the rules evaluated after it, as is everything when authorization is not enabled; queries are scoped to the
+// objects with the can_view relation before they run by interceptors.InterceptorTodoRelation
+func DenyIfMissingRelation(objectType fgax.Kind) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m generated.Mutation) error {
+		mc, ok := m.(interface {
+			Client() *generated.Client
+			ID() (string, bool)
+		})
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+
+		client := mc.Client()
+		if client.Authz.Ofga == nil {
+			return privacy.Skip
+		}
+
+		var relation string
+
+		switch {
+		case m.Op().Is(ent.OpUpdateOne):
+			relation = fgax.CanEdit
+		case m.Op().Is(ent.OpDeleteOne):
+			relation = fgax.CanDelete
+		default:
+			return privacy.Skip
+		}
+
+		id, ok := mc.ID()
+		if !ok {
+			return privacy.Skip
+		}
+
+		return checkRelation(ctx, client.Authz, objectType, id, relation)
+	})
+}
+
+// checkRelation skips to the next rule when the viewer has the relation with the object and denies otherwise,
+// the request is also denied when openFGA can not be reached
+func checkRelation(ctx context.Context, c fgax.Client, objectType fgax.Kind, id, relation string) error {
+	userID, err := interceptors.UserFromContext(ctx)
+	if err != nil {
+		return privacy.Denyf("anonymous viewer")
+	}
+
+	allowed, err := c.CheckAccess(ctx, fgax.AccessCheck{
+		ObjectType:  objectType,
+		ObjectID:    id,
+		SubjectID:   userID,
+		SubjectType: auth.GetAuthzSubjectType(ctx),
+		Relation:    relation,
+	})
+	if err != nil {
+		return privacy.Denyf("unable to check access: %v", err)
+	}
+
+	if !allowed {
+		return privacy.Denyf("viewer does not have the %s relation", relation)
+	}
+
+	return privacy.Skip
+}
@@ -0,0 +1,237 @@
+package rule_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/ent/privacy/rule"
+	"github.com/datumforge/go-template/internal/testutils"
+	"github.com/datumforge/go-template/pkg/fgamem"
+)
+
+// relationFixture holds an organization with an admin, a member and the todo created by another member,
+// the relationship tuples are written to an in-memory openFGA server
+type relationFixture struct {
+	srv    *fgamem.Server
+	client *generated.Client
+
+	org     *generated.Organization
+	creator *generated.User
+	admin   *generated.User
+	member  *generated.User
+	todo    *generated.Todo
+}
+
+func newRelationFixture(t *testing.T) *relationFixture {
+	t.Helper()
+
+	srv := fgamem.NewServer()
+	t.Cleanup(srv.Close)
+
+	fc, err := srv.NewClient(context.Background(), "../../../../fga/model/datum.fga", zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	f := &relationFixture{
+		srv:    srv,
+		client: testutils.NewTestClient(t, generated.Authz(*fc)),
+	}
+
+	ctx := interceptors.SkipTenant(context.Background())
+
+	f.org = f.client.Organization.Create().SetName("acme").SaveX(ctx)
+
+	newMember := func(email string, role orgmembership.Role) *generated.User {
+		u := f.client.User.Create().SetEmail(email).SetDisplayName(email).SaveX(ctx)
+		f.client.OrgMembership.Create().SetOrganizationID(f.org.ID).SetUserID(u.ID).SetRole(role).SaveX(ctx)
+
+		return u
+	}
+
+	f.creator = newMember("creator@acme.com", orgmembership.RoleMEMBER)
+	f.admin = newMember("admin@acme.com", orgmembership.RoleADMIN)
+	f.member = newMember("member@acme.com", orgmembership.RoleMEMBER)
+	f.todo = f.client.Todo.Create().SetName("todo").SaveX(testutils.UserContext(f.creator.ID, f.org.ID))
+
+	return f
+}
+
+// deleteTodoMutation returns the mutation deleting the todo, the delete builders do not expose their mutation
+func deleteTodoMutation(c *generated.Client, id string) generated.Mutation {
+	m := c.Todo.UpdateOneID(id).Mutation()
+	m.SetOp(ent.OpDeleteOne)
+
+	return m
+}
+
+func TestDenyIfMissingRelationMutation(t *testing.T) {
+	f := newRelationFixture(t)
+	denyRule := rule.DenyIfMissingRelation(hooks.TodoObjectType)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		mutation func(c *generated.Client) generated.Mutation
+		want     error
+	}{
+		{
+			name:     "creator updates the todo",
+			ctx:      testutils.UserContext(f.creator.ID, f.org.ID),
+			mutation: func(c *generated.Client) generated.Mutation { return c.Todo.UpdateOneID(f.todo.ID).Mutation() },
+		},
+		{
+			name:     "admin deletes the todo",
+			ctx:      testutils.UserContext(f.admin.ID, f.org.ID),
+			mutation: func(c *generated.Client) generated.Mutation { return deleteTodoMutation(c, f.todo.ID) },
+		},
+		{
+			name:     "member updates the todo",
+			ctx:      testutils.UserContext(f.member.ID, f.org.ID),
+			mutation: func(c *generated.Client) generated.Mutation { return c.Todo.UpdateOneID(f.todo.ID).Mutation() },
+			want:     privacy.Deny,
+		},
+		{
+			name:     "member deletes the todo",
+			ctx:      testutils.UserContext(f.member.ID, f.org.ID),
+			mutation: func(c *generated.Client) generated.Mutation { return deleteTodoMutation(c, f.todo.ID) },
+			want:     privacy.Deny,
+		},
+		{
+			name:     "anonymous updates the todo",
+			ctx:      context.Background(),
+			mutation: func(c *generated.Client) generated.Mutation { return c.Todo.UpdateOneID(f.todo.ID).Mutation() },
+			want:     privacy.Deny,
+		},
+		{
+			name:     "member creates a todo",
+			ctx:      testutils.UserContext(f.member.ID, f.org.ID),
+			mutation: func(c *generated.Client) generated.Mutation { return c.Todo.Create().Mutation() },
+		},
+		{
+			name:     "member updates todos in bulk",
+			ctx:      testutils.UserContext(f.member.ID, f.org.ID),
+			mutation: func(c *generated.Client) generated.Mutation { return c.Todo.Update().Mutation() },
+		},
+		{
+			name: "authorization is not enabled",
+			ctx:  testutils.UserContext(f.member.ID, f.org.ID),
+			mutation: func(*generated.Client) generated.Mutation {
+				return testutils.NewTestClient(t).Todo.UpdateOneID(f.todo.ID).Mutation()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertDecision(t, tt.want, denyRule.EvalMutation(tt.ctx, tt.mutation(f.client)))
+		})
+	}
+
+	t.Run("openFGA is not reachable", func(t *testing.T) {
+		f := newRelationFixture(t)
+		f.srv.Close()
+
+		m := f.client.Todo.UpdateOneID(f.todo.ID).Mutation()
+
+		assertDecision(t, privacy.Deny, denyRule.EvalMutation(testutils.UserContext(f.creator.ID, f.org.ID), m))
+	})
+}
+
+func TestTodoRelationQuery(t *testing.T) {
+	f := newRelationFixture(t)
+	member := testutils.UserContext(f.member.ID, f.org.ID)
+
+	// a todo of the organization without its relationship tuples, no member can view it
+	hidden := f.client.Todo.Create().SetName("hidden").SaveX(testutils.UserContext(f.creator.ID, f.org.ID))
+	require.NoError(t, f.client.Authz.DeleteAllObjectRelations(context.Background(), "todo:"+hidden.ID))
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		query        func(ctx context.Context) ([]string, error)
+		want         []string
+		wantNotFound bool
+	}{
+		{
+			name: "member lists the todos",
+			ctx:  member,
+			query: func(ctx context.Context) ([]string, error) {
+				todos, err := f.client.Todo.Query().All(ctx)
+
+				ids := make([]string, len(todos))
+				for i, t := range todos {
+					ids[i] = t.ID
+				}
+
+				return ids, err
+			},
+			want: []string{f.todo.ID},
+		},
+		{
+			name:  "member queries the ids of the todos",
+			ctx:   member,
+			query: func(ctx context.Context) ([]string, error) { return f.client.Todo.Query().IDs(ctx) },
+			want:  []string{f.todo.ID},
+		},
+		{
+			name: "member gets the todo without the relation",
+			ctx:  member,
+			query: func(ctx context.Context) ([]string, error) {
+				_, err := f.client.Todo.Get(ctx, hidden.ID)
+
+				return nil, err
+			},
+			wantNotFound: true,
+		},
+		{
+			name: "member lists the todos of a tag",
+			ctx:  member,
+			query: func(ctx context.Context) ([]string, error) {
+				tag := f.client.Tag.Create().SetName("urgent").AddTodoIDs(f.todo.ID, hidden.ID).SaveX(ctx)
+
+				return tag.QueryTodos().IDs(ctx)
+			},
+			want: []string{f.todo.ID},
+		},
+		{
+			name: "system lists the todos",
+			ctx:  interceptors.SkipTenant(context.Background()),
+			query: func(ctx context.Context) ([]string, error) {
+				return f.client.Todo.Query().IDs(ctx)
+			},
+			want: []string{f.todo.ID, hidden.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query(tt.ctx)
+
+			if tt.wantNotFound {
+				assert.True(t, generated.IsNotFound(err), err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+
+	t.Run("openFGA is not reachable", func(t *testing.T) {
+		f := newRelationFixture(t)
+		f.srv.Close()
+
+		_, err := f.client.Todo.Query().All(testutils.UserContext(f.member.ID, f.org.ID))
+		require.ErrorIs(t, err, privacy.Deny)
+	})
+}
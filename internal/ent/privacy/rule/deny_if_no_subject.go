@@ -0,0 +1,20 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// DenyIfNoSubject denies queries and mutations of anonymous viewers, the request must be
+// authenticated as a user for any of the rules evaluated after it to allow it
+func DenyIfNoSubject() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if _, err := interceptors.UserFromContext(ctx); err != nil {
+			return privacy.Denyf("anonymous viewer")
+		}
+
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,2 @@
+// Package rule contains the privacy rules used by the ent schema policies
+package rule
@@ -0,0 +1,10 @@
+package rule
+
+import "github.com/datumforge/go-template/internal/ent/generated/privacy"
+
+// queryMutationRule combines a query rule and a mutation rule for rules that are
+// evaluated differently for queries and mutations
+type queryMutationRule struct {
+	privacy.QueryRule
+	privacy.MutationRule
+}
@@ -0,0 +1,178 @@
+package rule_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/ent/privacy/rule"
+	"github.com/datumforge/go-template/internal/testutils"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+// assertDecision checks the rule returned the expected decision, nil expects the rule to skip
+func assertDecision(t *testing.T, want, err error) {
+	t.Helper()
+
+	if want == nil {
+		want = privacy.Skip
+	}
+
+	assert.ErrorIs(t, err, want)
+}
+
+func TestContextRules(t *testing.T) {
+	system := interceptors.SkipTenant(context.Background())
+	user := testutils.UserContext("user", "org")
+
+	tests := []struct {
+		name string
+		rule privacy.QueryMutationRule
+		ctx  context.Context
+		want error
+	}{
+		{
+			name: "deny if no subject, anonymous",
+			rule: rule.DenyIfNoSubject(),
+			ctx:  context.Background(),
+			want: privacy.Deny,
+		},
+		{
+			name: "deny if no subject, user",
+			rule: rule.DenyIfNoSubject(),
+			ctx:  user,
+		},
+		{
+			name: "allow if system, system context",
+			rule: rule.AllowIfSystem(),
+			ctx:  system,
+			want: privacy.Allow,
+		},
+		{
+			name: "allow if system, user",
+			rule: rule.AllowIfSystem(),
+			ctx:  user,
+		},
+		{
+			name: "allow if system, anonymous",
+			rule: rule.AllowIfSystem(),
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertDecision(t, tt.want, tt.rule.EvalQuery(tt.ctx, nil))
+			assertDecision(t, tt.want, tt.rule.EvalMutation(tt.ctx, nil))
+		})
+	}
+}
+
+func TestAllowIfOrgMemberWithRole(t *testing.T) {
+	client := testutils.NewTestClient(t)
+	ctx := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(ctx)
+	otherOrg := client.Organization.Create().SetName("globex").SaveX(ctx)
+
+	users := map[orgmembership.Role]string{}
+
+	for _, role := range []orgmembership.Role{orgmembership.RoleOWNER, orgmembership.RoleADMIN, orgmembership.RoleMEMBER} {
+		u := client.User.Create().SetEmail(role.String() + "@acme.com").SetDisplayName(role.String()).SaveX(ctx)
+		client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(role).SaveX(ctx)
+
+		users[role] = u.ID
+	}
+
+	outsider := client.User.Create().SetEmail("owner@globex.com").SetDisplayName("outsider").SaveX(ctx)
+	client.OrgMembership.Create().SetOrganizationID(otherOrg.ID).SetUserID(outsider.ID).SetRole(orgmembership.RoleOWNER).SaveX(ctx)
+
+	adminRule := rule.AllowIfOrgMemberWithRole(orgmembership.RoleOWNER, orgmembership.RoleADMIN)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{
+			name: "owner",
+			ctx:  testutils.UserContext(users[orgmembership.RoleOWNER], org.ID),
+			want: privacy.Allow,
+		},
+		{
+			name: "admin",
+			ctx:  testutils.UserContext(users[orgmembership.RoleADMIN], org.ID),
+			want: privacy.Allow,
+		},
+		{
+			name: "member without the role",
+			ctx:  testutils.UserContext(users[orgmembership.RoleMEMBER], org.ID),
+		},
+		{
+			name: "owner of another organization",
+			ctx:  testutils.UserContext(outsider.ID, org.ID),
+			want: privacy.Deny,
+		},
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := client.Todo.Create().SetName("todo").Mutation()
+
+			assertDecision(t, tt.want, adminRule.EvalMutation(tt.ctx, m))
+		})
+	}
+}
+
+func TestAllowIfOwner(t *testing.T) {
+	client := testutils.NewTestClient(t)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		op   ent.Op
+		want error
+	}{
+		{
+			name: "update is filtered to the viewer",
+			ctx:  testutils.UserContext("user", "org"),
+			op:   ent.OpUpdateOne,
+			want: privacy.Allow,
+		},
+		{
+			name: "delete is filtered to the viewer",
+			ctx:  testutils.UserContext("user", "org"),
+			op:   ent.OpDeleteOne,
+			want: privacy.Allow,
+		},
+		{
+			name: "create is skipped",
+			ctx:  testutils.UserContext("user", "org"),
+			op:   ent.OpCreate,
+		},
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			op:   ent.OpUpdateOne,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := client.Todo.Create().Mutation()
+			m.SetOp(tt.op)
+
+			assertDecision(t, tt.want, rule.AllowIfOwner().EvalMutation(tt.ctx, m))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/datumforge/go-template/internal/ent/hooks"
+)
+
+// AuditMixin records when and by whom an object was created and last updated, the fields are
+// set by the audit hook and are read-only in the graphql schema
+type AuditMixin struct {
+	mixin.Schema
+}
+
+// Fields of the AuditMixin
+func (AuditMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Comment("the time the object was created").
+			Immutable().
+			Optional().
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("created_at"),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("updated_at").
+			Comment("the time the object was last updated").
+			Optional().
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				entgql.OrderField("updated_at"),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("created_by").
+			Comment("the user or system actor that created the object").
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("updated_by").
+			Comment("the user or system actor that last updated the object").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Hooks of the AuditMixin
+func (AuditMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookAudit(),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/datumforge/go-template/internal/ent/ids"
+)
+
+// IDMixin adds an immutable string ID to the schema, generated as a sortable ulid with a per type
+// prefix, e.g. todo_01H... so the type of an object can be determined from its ID
+type IDMixin struct {
+	mixin.Schema
+	// Prefix added to the generated IDs
+	Prefix string
+}
+
+// Fields of the IDMixin
+func (i IDMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Immutable().
+			DefaultFunc(func() string { return ids.New(i.Prefix) }).
+			Validate(ids.Validate(i.Prefix)),
+	}
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/ids"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// Organization holds the schema definition for the Organization entity, organizations are the
+// tenants of the service and own the todos
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("the name of the organization").
+			NotEmpty().
+			Annotations(
+				entgql.OrderField("name"),
+			),
+		field.String("description").
+			Comment("an optional description of the organization").
+			Optional(),
+	}
+}
+
+// Mixin of the Organization
+func (Organization) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{Prefix: ids.OrganizationPrefix},
+		AuditMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+// Edges of the Organization
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", OrgMembership.Type),
+		edge.To("todos", Todo.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("tags", Tag.Type).
+			Annotations(entgql.RelayConnection()),
+	}
+}
+
+func (Organization) Indexes() []ent.Index {
+	return []ent.Index{
+		// soft deleted organizations do not block reusing the name
+		index.Fields("name").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the Organization
+func (Organization) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// the organizations query only returns the organizations of the current user
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entx.SchemaGenSkip(true),
+		enthistory.Annotations{
+			Exclude: true,
+		},
+	}
+}
+
+// Interceptors of the Organization
+func (Organization) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorOrganization(),
+	}
+}
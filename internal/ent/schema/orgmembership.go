@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/ids"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// OrgMembership holds the schema definition for the OrgMembership entity, it joins a user to an organization
+type OrgMembership struct {
+	ent.Schema
+}
+
+// Fields of the OrgMembership
+func (OrgMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Comment("the role of the user in the organization").
+			Values("OWNER", "ADMIN", "MEMBER").
+			Default("MEMBER").
+			Annotations(
+				entgql.OrderField("role"),
+			),
+		field.String("organization_id").
+			Comment("the id of the organization").
+			Immutable(),
+		field.String("user_id").
+			Comment("the id of the member").
+			Immutable(),
+	}
+}
+
+// Mixin of the OrgMembership
+func (OrgMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{Prefix: ids.OrgMembershipPrefix},
+		AuditMixin{},
+	}
+}
+
+// Edges of the OrgMembership
+func (OrgMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("members").
+			Field("organization_id").
+			Required().
+			Immutable().
+			Unique(),
+		edge.From("user", User.Type).
+			Ref("memberships").
+			Field("user_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+func (OrgMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// a user can only be a member of an organization once
+		index.Fields("user_id", "organization_id").
+			Unique(),
+	}
+}
+
+// Annotations of the OrgMembership
+func (OrgMembership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SchemaGenSkip(true),
+		enthistory.Annotations{
+			Exclude: true,
+		},
+	}
+}
+
+// Hooks of the OrgMembership
+func (OrgMembership) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookOrgMembershipAuthz(),
+	}
+}
+
+// Interceptors of the OrgMembership
+func (OrgMembership) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		// the memberships of other organizations are hidden, also when traversing from the user
+		interceptors.InterceptorTenant("organization_id"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// ownerField holds the id of the organization that owns the object
+const ownerField = "owner_id"
+
+// OrgOwnedMixin adds the owning organization to the schema, the owner is set from the auth context
+// when the object is created and all queries and mutations are scoped to the organization in the auth context
+type OrgOwnedMixin struct {
+	mixin.Schema
+	// Ref is the name of the edge from the organization to the schema, e.g. todos
+	Ref string
+}
+
+// Fields of the OrgOwnedMixin
+func (OrgOwnedMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String(ownerField).
+			Comment("the id of the organization that owns the object").
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Edges of the OrgOwnedMixin
+func (o OrgOwnedMixin) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Organization.Type).
+			Ref(o.Ref).
+			Field(ownerField).
+			Immutable().
+			Unique(),
+	}
+}
+
+// Hooks of the OrgOwnedMixin
+func (OrgOwnedMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookTenant(ownerField),
+	}
+}
+
+// Interceptors of the OrgOwnedMixin
+func (OrgOwnedMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorTenant(ownerField),
+	}
+}
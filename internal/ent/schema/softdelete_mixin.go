@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// softDeleteField is the field set when an object is soft deleted
+const softDeleteField = "deleted_at"
+
+// SoftDeleteMixin turns deletes into updates of the deleted_at and deleted_by fields and hides
+// the soft deleted objects from queries, they can be restored until they are purged
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time(softDeleteField).
+			Comment("the time the object was deleted").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("deleted_by").
+			Comment("the user or system actor that deleted the object").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Hooks of the SoftDeleteMixin
+func (SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookSoftDelete(softDeleteField),
+	}
+}
+
+// Interceptors of the SoftDeleteMixin
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorSoftDelete(softDeleteField),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/enthistory"
+
+	"github.com/datumforge/go-template/internal/ent/ids"
+)
+
+// Tag holds the schema definition for the Tag entity, tags label the todos of an organization
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("the name of the tag").
+			NotEmpty().
+			Annotations(
+				entgql.OrderField("name"),
+			),
+		field.String("color").
+			Comment("the color of the tag as a hex code, e.g. #1f883d").
+			Optional().
+			Match(regexp.MustCompile("^#[0-9a-fA-F]{6}$")),
+		field.String("description").
+			Comment("an optional description of the tag").
+			Optional(),
+	}
+}
+
+// Mixin of the Tag
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{Prefix: ids.TagPrefix},
+		AuditMixin{},
+		OrgOwnedMixin{Ref: "tags"},
+	}
+}
+
+// Edges of the Tag
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		// tags are added to todos with the todo mutations
+		edge.From("todos", Todo.Type).
+			Ref("tags").
+			Annotations(
+				entgql.RelayConnection(),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		// names are unique within an organization
+		index.Fields(ownerField, "name").
+			Unique(),
+	}
+}
+
+// Annotations of the Tag
+func (Tag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+		enthistory.Annotations{
+			Exclude: true,
+		},
+	}
+}
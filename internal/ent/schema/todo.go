@@ -3,9 +3,18 @@ package schema
 import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/ids"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/ent/privacy/rule"
 )
 
 // Todo holds the example schema definition for the Todo entity
@@ -16,27 +25,128 @@ type Todo struct {
 // Fields of the Todo
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("id").
-			Immutable(),
 		field.String("name").
-			Comment("the name of the organization").
-			NotEmpty(),
+			Comment("the name of the todo").
+			NotEmpty().
+			Annotations(
+				entgql.OrderField("name"),
+			),
 		field.String("description").
-			Comment("An optional description of the organization").
+			Comment("an optional description of the todo").
 			Optional(),
+		field.Enum("status").
+			Comment("the workflow status of the todo, transitions are enforced by the status hook").
+			Values("OPEN", "IN_PROGRESS", "BLOCKED", "DONE", "CANCELED").
+			Default("OPEN").
+			Annotations(
+				entgql.OrderField("status"),
+			),
+		field.Int("priority").
+			Comment("the priority of the todo, higher values are more urgent").
+			Default(0).
+			NonNegative().
+			Annotations(
+				entgql.OrderField("priority"),
+			),
+		field.Time("due_date").
+			Comment("the optional time the todo is due").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.OrderField("due_date"),
+			),
+		field.Time("completed_at").
+			Comment("the time the todo was completed, set when the status changes to DONE").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.OrderField("completed_at"),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Int("version").
+			Comment("the version of the todo, incremented on every update and used to detect concurrent changes").
+			Default(1).
+			Positive().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Edges of the Todo
+func (Todo) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tags", Tag.Type).
+			Annotations(entgql.RelayConnection()),
+	}
+}
+
+// Mixin of the Todo
+func (Todo) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{Prefix: ids.TodoPrefix},
+		AuditMixin{},
+		SoftDeleteMixin{},
+		OrgOwnedMixin{Ref: "todos"},
 	}
 }
 
 func (Todo) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name").
-			Unique(),
+		// names are unique within an organization, soft deleted todos do not block reusing the name
+		index.Fields(ownerField, "name").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at is NULL")),
 	}
 }
 
-// Annotations of the Organization
+// Annotations of the Todo
 func (Todo) Annotations() []schema.Annotation {
 	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 	}
 }
+
+// Hooks of the Todo
+func (Todo) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookTodoVersion(),
+		hooks.HookTodoStatus(),
+		hooks.HookTodoTags(),
+		hooks.HookTodoEvents(),
+		hooks.HookTodoAuthz(),
+	}
+}
+
+// Interceptors of the Todo
+func (Todo) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorTodoRelation(hooks.TodoObjectType),
+	}
+}
+
+// Policy of the Todo, every member of the organization can read its todos and create new ones,
+// owners and admins can change all todos while members can only change the todos they created;
+// when authorization is enabled the todos are also checked against their relations in openFGA,
+// updates and deletes by the policy and queries are scoped to the viewable todos by the interceptor
+func (Todo) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoSubject(),
+			rule.DenyIfMissingRelation(hooks.TodoObjectType),
+			rule.AllowIfOrgMemberWithRole(orgmembership.RoleOWNER, orgmembership.RoleADMIN),
+			privacy.OnMutationOperation(rule.AllowIfOrgMemberWithRole(orgmembership.RoleMEMBER), ent.OpCreate),
+			rule.AllowIfOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoSubject(),
+			rule.AllowIfOrgMemberWithRole(orgmembership.RoleOWNER, orgmembership.RoleADMIN, orgmembership.RoleMEMBER),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/ids"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// User holds the schema definition for the User entity
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			Comment("the email address of the user").
+			NotEmpty().
+			Annotations(
+				entgql.OrderField("email"),
+			),
+		field.String("display_name").
+			Comment("the name of the user shown to other users").
+			Optional().
+			Annotations(
+				entgql.OrderField("display_name"),
+			),
+		field.String("password").
+			Comment("the derived key of the password used to log in, users without a password can not log in").
+			Optional().
+			Nillable().
+			Sensitive().
+			Annotations(
+				entgql.Skip(),
+			),
+	}
+}
+
+// Mixin of the User
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{Prefix: ids.UserPrefix},
+		AuditMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+// Edges of the User
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", OrgMembership.Type),
+	}
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// soft deleted users do not block reusing the email address
+		index.Fields("email").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the User
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SchemaGenSkip(true),
+		enthistory.Annotations{
+			Exclude: true,
+		},
+	}
+}
+
+// Hooks of the User
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookUserPassword(),
+	}
+}
+
+// Interceptors of the User
+func (User) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		interceptors.InterceptorUser(),
+	}
+}
@@ -0,0 +1,3 @@
+// Package search provides the full-text search over todos, using a tsvector column with a GIN index
+// on Postgres and an FTS5 virtual table on SQLite; the search objects are not part of the ent schema
+package search
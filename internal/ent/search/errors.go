@@ -0,0 +1,10 @@
+package search
+
+import "errors"
+
+var (
+	// ErrEmptyQuery is returned when the search query does not contain any terms
+	ErrEmptyQuery = errors.New("search query is empty")
+	// ErrUnsupportedDialect is returned when searching a database that does not support full-text search
+	ErrUnsupportedDialect = errors.New("full-text search is not supported by the database dialect")
+)
@@ -0,0 +1,156 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+)
+
+const (
+	// searchColumn is the generated tsvector column of the todos table on Postgres
+	searchColumn = "search_vector"
+	// searchIndex is the GIN index of the search column on Postgres
+	searchIndex = "todo_search_vector"
+	// ftsTable is the FTS5 virtual table indexing the todos on SQLite
+	ftsTable = "todos_fts"
+)
+
+// postgresStatements create the weighted search column, names rank above descriptions
+var postgresStatements = []string{
+	`ALTER TABLE "todos" ADD COLUMN IF NOT EXISTS "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english', coalesce("name", '')), 'A') || setweight(to_tsvector('english', coalesce("description", '')), 'B')) STORED`,
+	`CREATE INDEX IF NOT EXISTS "todo_search_vector" ON "todos" USING GIN ("search_vector")`,
+}
+
+// sqliteStatements create the FTS5 table indexing the todos, the triggers keep it in sync. The table stores the
+// id of each todo in an unindexed column, the rowid of the todos is not stable as their primary key is the id
+var sqliteStatements = []string{
+	"CREATE VIRTUAL TABLE IF NOT EXISTS `todos_fts` USING fts5(`id` UNINDEXED, `name`, `description`)",
+	"CREATE TRIGGER IF NOT EXISTS `todos_fts_insert` AFTER INSERT ON `todos` BEGIN INSERT INTO `todos_fts` (`id`, `name`, `description`) VALUES (new.`id`, new.`name`, new.`description`); END",
+	"CREATE TRIGGER IF NOT EXISTS `todos_fts_delete` AFTER DELETE ON `todos` BEGIN DELETE FROM `todos_fts` WHERE `id` = old.`id`; END",
+	"CREATE TRIGGER IF NOT EXISTS `todos_fts_update` AFTER UPDATE ON `todos` BEGIN UPDATE `todos_fts` SET `name` = new.`name`, `description` = new.`description` WHERE `id` = old.`id`; END",
+}
+
+// sqliteIndexStatement indexes the todos created before the search table
+const sqliteIndexStatement = "INSERT INTO `todos_fts` (`id`, `name`, `description`) SELECT `id`, `name`, `description` FROM `todos`"
+
+// CreateIndexes creates the search objects for the dialect of the driver if they do not exist, it is run
+// after the ent schema is created; the versioned migrations create the same objects
+func CreateIndexes(ctx context.Context, drv *entsql.Driver) error {
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		return execAll(ctx, drv, postgresStatements)
+	case dialect.SQLite:
+		exists, err := queryInt(ctx, drv, "SELECT count(*) FROM `sqlite_master` WHERE `name` = ?", ftsTable)
+		if err != nil {
+			return err
+		}
+
+		if err := execAll(ctx, drv, sqliteStatements); err != nil {
+			return err
+		}
+
+		if exists > 0 {
+			return nil
+		}
+
+		// the todos created before the search table are indexed once, when it is created
+		return execAll(ctx, drv, []string{sqliteIndexStatement})
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedDialect, drv.Dialect())
+	}
+}
+
+// queryInt returns the single integer selected by the query
+func queryInt(ctx context.Context, drv *entsql.Driver, query string, args ...any) (int, error) {
+	var rows entsql.Rows
+	if err := drv.Query(ctx, query, args, &rows); err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	return entsql.ScanInt(&rows)
+}
+
+// execAll executes the statements in order
+func execAll(ctx context.Context, drv *entsql.Driver, statements []string) error {
+	for _, stmt := range statements {
+		if _, err := drv.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%w: %s", err, stmt)
+		}
+	}
+
+	return nil
+}
+
+// DiffHook removes the changes dropping the search objects from the migration diff, the objects
+// are created by the migrations but are not part of the ent schema
+func DiffHook(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered := make([]atlas.Change, 0, len(changes))
+
+		for _, c := range changes {
+			switch c := c.(type) {
+			case *atlas.DropTable:
+				if isFTSTable(c.T.Name) {
+					continue
+				}
+			case *atlas.ModifyTable:
+				if isFTSTable(c.T.Name) {
+					continue
+				}
+
+				if c.T.Name == todo.Table {
+					c.Changes = filterTodoChanges(c.Changes)
+
+					if len(c.Changes) == 0 {
+						continue
+					}
+				}
+			}
+
+			filtered = append(filtered, c)
+		}
+
+		return filtered, nil
+	})
+}
+
+// filterTodoChanges removes the changes dropping the search column and index of the todos table
+func filterTodoChanges(changes []atlas.Change) []atlas.Change {
+	filtered := make([]atlas.Change, 0, len(changes))
+
+	for _, c := range changes {
+		switch c := c.(type) {
+		case *atlas.DropColumn:
+			if c.C.Name == searchColumn {
+				continue
+			}
+		case *atlas.DropIndex:
+			if c.I.Name == searchIndex {
+				continue
+			}
+		}
+
+		filtered = append(filtered, c)
+	}
+
+	return filtered
+}
+
+// isFTSTable returns true for the FTS5 table and the shadow tables SQLite creates for it
+func isFTSTable(name string) bool {
+	return name == ftsTable || strings.HasPrefix(name, ftsTable+"_")
+}
@@ -0,0 +1,213 @@
+package search
+
+import (
+	"context"
+	"html"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/predicate"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+)
+
+// Markers added around the matched terms in the highlights, the rest of the highlights is HTML escaped
+const (
+	// HighlightStart is added before each matched term
+	HighlightStart = "<mark>"
+	// HighlightEnd is added after each matched term
+	HighlightEnd = "</mark>"
+)
+
+// Markers added around the matched terms by the database, they are replaced with the highlight markers once
+// the text of the todo is escaped; control characters are used so they are not part of the escaped text
+const (
+	matchStart = "\x02"
+	matchEnd   = "\x03"
+)
+
+// descriptionSnippetWords is the max number of words of the description returned in the highlight
+const descriptionSnippetWords = 16
+
+// Result is a todo matching the search, ranked by relevance with the matched terms highlighted
+type Result struct {
+	// ID of the matched todo
+	ID string `sql:"id"`
+	// Rank of the match, higher ranks are more relevant
+	Rank float64 `sql:"rank"`
+	// NameHighlight is the name of the todo with the matched terms highlighted
+	NameHighlight string `sql:"name_highlight"`
+	// DescriptionHighlight is a snippet of the description with the matched terms highlighted
+	DescriptionHighlight string `sql:"description_highlight"`
+}
+
+// Todos returns the todos matching the query, ordered by rank; the search is made with the ent client
+// so the results are scoped by the todo interceptors, e.g. to the organization in the auth context
+func Todos(ctx context.Context, client *generated.Client, query string, limit, offset int) ([]Result, error) {
+	if len(strings.Fields(query)) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	var results []Result
+
+	err := client.Todo.Query().
+		Limit(limit).
+		Offset(offset).
+		Modify(func(s *sql.Selector) {
+			switch s.Dialect() {
+			case dialect.Postgres:
+				selectPostgres(s, query)
+			default:
+				selectSQLite(s, query)
+			}
+
+			s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+				b.Ident("rank").WriteString(" DESC")
+			}))
+			s.OrderBy(s.C(todo.FieldID))
+		}).
+		Scan(ctx, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].NameHighlight = escapeHighlight(results[i].NameHighlight)
+		results[i].DescriptionHighlight = escapeHighlight(results[i].DescriptionHighlight)
+	}
+
+	return results, nil
+}
+
+// escapeHighlight HTML escapes the text of the highlight and replaces the match markers of the database with
+// the highlight markers, markers that are not balanced, e.g. because the text contained them, are dropped
+func escapeHighlight(s string) string {
+	var (
+		b    strings.Builder
+		open bool
+	)
+
+	for s != "" {
+		i := strings.IndexAny(s, matchStart+matchEnd)
+		if i < 0 {
+			b.WriteString(html.EscapeString(s))
+
+			break
+		}
+
+		b.WriteString(html.EscapeString(s[:i]))
+
+		switch marker := s[i : i+1]; {
+		case marker == matchStart && !open:
+			b.WriteString(HighlightStart)
+
+			open = true
+		case marker == matchEnd && open:
+			b.WriteString(HighlightEnd)
+
+			open = false
+		}
+
+		s = s[i+1:]
+	}
+
+	if open {
+		b.WriteString(HighlightEnd)
+	}
+
+	return b.String()
+}
+
+// CountTodos returns the number of todos matching the query
+func CountTodos(ctx context.Context, client *generated.Client, query string) (int, error) {
+	if len(strings.Fields(query)) == 0 {
+		return 0, ErrEmptyQuery
+	}
+
+	return client.Todo.Query().
+		Where(predicate.Todo(func(s *sql.Selector) {
+			switch s.Dialect() {
+			case dialect.Postgres:
+				s.Where(postgresMatch(s, query))
+			default:
+				// the FTS5 table can only be matched when it is queried directly
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString(s.C(todo.FieldID)).WriteString(" IN (SELECT ").Ident("id").
+						WriteString(" FROM ").Ident(ftsTable).WriteString(" WHERE ").Ident(ftsTable).
+						WriteString(" MATCH ").Arg(ftsQuery(query)).WriteString(")")
+				}))
+			}
+		})).
+		Count(ctx)
+}
+
+// selectPostgres selects the rank and highlights of the todos matching the query using the search column
+func selectPostgres(s *sql.Selector, query string) {
+	tsquery := func(b *sql.Builder) {
+		b.WriteString("websearch_to_tsquery('english', ").Arg(query).WriteString(")")
+	}
+
+	headline := func(column string) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline('english', coalesce(").WriteString(s.C(column)).WriteString(", ''), ")
+			tsquery(b)
+			b.WriteString(", ").Arg("StartSel=" + matchStart + ", StopSel=" + matchEnd + ", MaxWords=" + strconv.Itoa(descriptionSnippetWords) + ", MinWords=1").
+				WriteString(")")
+		})
+	}
+
+	s.Select(s.C(todo.FieldID))
+	s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("ts_rank(").WriteString(s.C(searchColumn)).WriteString(", ")
+		tsquery(b)
+		b.WriteString(")")
+	}), "rank")
+	s.AppendSelectExprAs(headline(todo.FieldName), "name_highlight")
+	s.AppendSelectExprAs(headline(todo.FieldDescription), "description_highlight")
+	s.Where(postgresMatch(s, query))
+}
+
+// postgresMatch returns the predicate matching the search column against the query, websearch_to_tsquery
+// accepts any user input so the query does not need to be escaped
+func postgresMatch(s *sql.Selector, query string) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString(s.C(searchColumn)).WriteString(" @@ websearch_to_tsquery('english', ").Arg(query).WriteString(")")
+	})
+}
+
+// selectSQLite selects the rank and highlights of the todos matching the query by joining the FTS5 table
+func selectSQLite(s *sql.Selector, query string) {
+	fts := sql.Table(ftsTable)
+
+	s.Join(fts).On(s.C(todo.FieldID), fts.C("id"))
+	s.Select(s.C(todo.FieldID))
+	// bm25 returns lower values for better matches
+	s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("-bm25(").Ident(ftsTable).WriteString(")")
+	}), "rank")
+	s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("highlight(").Ident(ftsTable).WriteString(", 1, ").Arg(matchStart).WriteString(", ").Arg(matchEnd).WriteString(")")
+	}), "name_highlight")
+	s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("coalesce(snippet(").Ident(ftsTable).WriteString(", 2, ").Arg(matchStart).WriteString(", ").Arg(matchEnd).
+			WriteString(", '...', ").WriteString(strconv.Itoa(descriptionSnippetWords)).WriteString("), '')")
+	}), "description_highlight")
+	s.Where(sql.P(func(b *sql.Builder) {
+		b.Ident(ftsTable).WriteString(" MATCH ").Arg(ftsQuery(query))
+	}))
+}
+
+// ftsQuery quotes each term of the query so the FTS5 query syntax in the user input is not interpreted,
+// all terms must match and are matched as prefixes
+func ftsQuery(query string) string {
+	terms := strings.Fields(query)
+
+	for i, t := range terms {
+		terms[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"*`
+	}
+
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,57 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeHighlight(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "no matches",
+			text: "buy milk",
+			want: "buy milk",
+		},
+		{
+			name: "matched terms",
+			text: "buy \x02milk\x03 and \x02eggs\x03",
+			want: "buy <mark>milk</mark> and <mark>eggs</mark>",
+		},
+		{
+			name: "html in the text is escaped",
+			text: "<script>alert(1)</script> \x02<b>milk</b>\x03",
+			want: "&lt;script&gt;alert(1)&lt;/script&gt; <mark>&lt;b&gt;milk&lt;/b&gt;</mark>",
+		},
+		{
+			name: "stray end marker is dropped",
+			text: "buy\x03 \x02milk\x03",
+			want: "buy <mark>milk</mark>",
+		},
+		{
+			name: "nested start marker is dropped",
+			text: "\x02buy \x02milk\x03",
+			want: "<mark>buy milk</mark>",
+		},
+		{
+			name: "open marker is closed",
+			text: "buy \x02milk",
+			want: "buy <mark>milk</mark>",
+		},
+		{
+			name: "empty",
+			text: "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, escapeHighlight(tt.text))
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/datumforge/datum/pkg/testutils"
 
 	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/search"
 )
 
 const (
@@ -62,7 +64,7 @@ func NewMultiDriverDBClient(ctx context.Context, c entx.Config, l *zap.SugaredLo
 	client.pc = client.createEntDBClient(entConfig.GetPrimaryDB())
 
 	if c.RunMigrations {
-		if err := client.createSchema(ctx); err != nil {
+		if err := client.createSchema(ctx, entConfig.GetPrimaryDB()); err != nil {
 			client.logger.Errorf("failed creating schema resources", zap.Error(err))
 
 			return nil, nil, err
@@ -81,7 +83,7 @@ func NewMultiDriverDBClient(ctx context.Context, c entx.Config, l *zap.SugaredLo
 		client.sc = client.createEntDBClient(entConfig.GetSecondaryDB())
 
 		if c.RunMigrations {
-			if err := client.createSchema(ctx); err != nil {
+			if err := client.createSchema(ctx, entConfig.GetSecondaryDB()); err != nil {
 				client.logger.Errorf("failed creating schema resources", zap.Error(err))
 
 				return nil, nil, err
@@ -109,10 +111,15 @@ func NewMultiDriverDBClient(ctx context.Context, c entx.Config, l *zap.SugaredLo
 	// add authz hooks
 	ec.WithAuthz()
 
+	if c.EnableHistory {
+		// add history hooks
+		ec.Todo.Use(hooks.HookTodoHistory())
+	}
+
 	return ec, entConfig, nil
 }
 
-func (c *client) createSchema(ctx context.Context) error {
+func (c *client) createSchema(ctx context.Context, db *entsql.Driver) error {
 	// Run the automatic migration tool to create all schema resources.
 	// entcache.Driver will skip the caching layer when running the schema migration
 	if err := c.pc.Schema.Create(entcache.Skip(ctx)); err != nil {
@@ -121,6 +128,13 @@ func (c *client) createSchema(ctx context.Context) error {
 		return err
 	}
 
+	// the search indexes are not part of the ent schema
+	if err := search.CreateIndexes(ctx, db); err != nil {
+		c.logger.Errorf("failed creating search indexes", zap.Error(err))
+
+		return err
+	}
+
 	return nil
 }
 
@@ -170,6 +184,7 @@ func NewTestClient(ctx context.Context, ctr *testutils.TestFixture, entOpts []en
 		DriverName:      ctr.Dialect,
 		PrimaryDBSource: ctr.URI,
 		CacheTTL:        -1 * time.Second, // do not cache results in tests
+		RunMigrations:   true,             // create the search indexes with the schema
 	}
 
 	entOpts = append(entOpts, ent.Logger(*logger))
@@ -0,0 +1,297 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultBufferSize is the number of events buffered for each subscriber when not configured
+	defaultBufferSize = 16
+)
+
+var (
+	// ErrBrokerClosed is returned when publishing or subscribing on a closed broker
+	ErrBrokerClosed = errors.New("event broker is closed")
+)
+
+// Broker publishes events to the subscribers of a topic; by default events are only delivered to subscribers
+// of the same process, when a redis client is configured events are published through redis pub/sub
+// so that subscribers connected to any replica of the server receive them. The subscribers of a topic
+// in the process share a single redis subscription, the events are fanned out to them locally
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan []byte]struct{}
+	topics      map[string]*topicSubscription
+	closed      bool
+
+	redis      *redis.Client
+	bufferSize int
+	logger     *zap.SugaredLogger
+}
+
+// topicSubscription is the redis subscription of a topic shared by the subscribers of the process,
+// ready is closed once the subscription is confirmed or failed with err
+type topicSubscription struct {
+	ps    *redis.PubSub
+	ready chan struct{}
+	err   error
+}
+
+// Option is a functional option for the broker
+type Option func(*Broker)
+
+// WithRedis publishes events through redis pub/sub using the provided client
+func WithRedis(rc *redis.Client) Option {
+	return func(b *Broker) {
+		b.redis = rc
+	}
+}
+
+// WithBufferSize sets the number of events buffered for each subscriber
+func WithBufferSize(size int) Option {
+	return func(b *Broker) {
+		if size > 0 {
+			b.bufferSize = size
+		}
+	}
+}
+
+// WithLogger sets the logger for the broker
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(b *Broker) {
+		b.logger = l
+	}
+}
+
+// NewBroker returns a new event broker configured with the given options
+func NewBroker(opts ...Option) *Broker {
+	b := &Broker{
+		subscribers: map[string]map[chan []byte]struct{}{},
+		topics:      map[string]*topicSubscription{},
+		bufferSize:  defaultBufferSize,
+		logger:      zap.NewNop().Sugar(),
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Publish sends the payload to all subscribers of the topic
+func (b *Broker) Publish(ctx context.Context, topic string, payload []byte) error {
+	b.mu.RLock()
+	closed := b.closed
+	b.mu.RUnlock()
+
+	if closed {
+		return ErrBrokerClosed
+	}
+
+	if b.redis != nil {
+		return b.redis.Publish(ctx, topic, payload).Err()
+	}
+
+	b.deliver(topic, payload)
+
+	return nil
+}
+
+// Subscribe returns a channel that receives the payload of every event published to the topic
+// the channel is closed once the context is done or the broker is closed
+func (b *Broker) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	b.mu.Lock()
+
+	if b.closed {
+		b.mu.Unlock()
+
+		return nil, ErrBrokerClosed
+	}
+
+	ch := make(chan []byte, b.bufferSize)
+
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = map[chan []byte]struct{}{}
+	}
+
+	b.subscribers[topic][ch] = struct{}{}
+
+	// the first subscriber of the topic subscribes to redis, the others wait for the subscription
+	var (
+		ts     *topicSubscription
+		create bool
+	)
+
+	if b.redis != nil {
+		ts = b.topics[topic]
+		if ts == nil {
+			ts = &topicSubscription{ready: make(chan struct{})}
+			b.topics[topic] = ts
+			create = true
+		}
+	}
+
+	b.mu.Unlock()
+
+	if create {
+		b.subscribeRedis(ctx, topic, ts)
+	}
+
+	if ts != nil {
+		select {
+		case <-ts.ready:
+		case <-ctx.Done():
+			b.unsubscribe(topic, ch)
+
+			return nil, ctx.Err()
+		}
+
+		if ts.err != nil {
+			b.unsubscribe(topic, ch)
+
+			return nil, ts.err
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(topic, ch)
+	}()
+
+	return ch, nil
+}
+
+// subscribeRedis subscribes to the topic in redis and waits for the subscription to be confirmed so no
+// events are missed; the subscription is shared, it is not cancelled with the context of the subscriber
+func (b *Broker) subscribeRedis(ctx context.Context, topic string, ts *topicSubscription) {
+	defer close(ts.ready)
+
+	ctx = context.WithoutCancel(ctx)
+
+	ps := b.redis.Subscribe(ctx, topic)
+
+	if _, err := ps.Receive(ctx); err != nil {
+		ts.err = err
+
+		b.mu.Lock()
+		if b.topics[topic] == ts {
+			delete(b.topics, topic)
+		}
+		b.mu.Unlock()
+
+		_ = ps.Close()
+
+		return
+	}
+
+	ts.ps = ps
+
+	go b.receive(topic, ps)
+}
+
+// Close closes the broker, the redis subscriptions and all subscriber channels
+func (b *Broker) Close() error {
+	b.mu.Lock()
+
+	if b.closed {
+		b.mu.Unlock()
+
+		return nil
+	}
+
+	b.closed = true
+
+	for topic, subs := range b.subscribers {
+		for ch := range subs {
+			close(ch)
+		}
+
+		delete(b.subscribers, topic)
+	}
+
+	topics := b.topics
+	b.topics = map[string]*topicSubscription{}
+
+	b.mu.Unlock()
+
+	for _, ts := range topics {
+		b.closeSubscription(ts)
+	}
+
+	return nil
+}
+
+// receive delivers the messages of the redis subscription of the topic to the subscribers of the process,
+// it returns once the subscription is closed
+func (b *Broker) receive(topic string, ps *redis.PubSub) {
+	for msg := range ps.Channel() {
+		b.deliver(topic, []byte(msg.Payload))
+	}
+}
+
+// closeSubscription closes the redis subscription once it is confirmed
+func (b *Broker) closeSubscription(ts *topicSubscription) {
+	<-ts.ready
+
+	if ts.ps != nil {
+		if err := ts.ps.Close(); err != nil {
+			b.logger.Warnw("unable to close redis subscription", "error", err)
+		}
+	}
+}
+
+// deliver sends the payload to all subscribers of the topic in this process
+func (b *Broker) deliver(topic string, payload []byte) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers[topic] {
+		b.sendLocked(topic, ch, payload)
+	}
+}
+
+// sendLocked delivers the payload without blocking the publisher, events are dropped
+// when the subscriber is not keeping up; the caller must hold the read lock
+func (b *Broker) sendLocked(topic string, ch chan []byte, payload []byte) {
+	select {
+	case ch <- payload:
+	default:
+		b.logger.Warnw("subscriber buffer full, dropping event", "topic", topic)
+	}
+}
+
+// unsubscribe removes the subscriber from the topic and closes the channel, the redis subscription
+// of the topic is closed with its last subscriber
+func (b *Broker) unsubscribe(topic string, ch chan []byte) {
+	b.mu.Lock()
+
+	if _, ok := b.subscribers[topic][ch]; !ok {
+		b.mu.Unlock()
+
+		return
+	}
+
+	delete(b.subscribers[topic], ch)
+	close(ch)
+
+	var ts *topicSubscription
+
+	if len(b.subscribers[topic]) == 0 {
+		delete(b.subscribers, topic)
+
+		ts = b.topics[topic]
+		delete(b.topics, topic)
+	}
+
+	b.mu.Unlock()
+
+	if ts != nil {
+		b.closeSubscription(ts)
+	}
+}
@@ -0,0 +1,158 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receiveTimeout is how long the tests wait for an event
+const receiveTimeout = time.Second
+
+// newRedisBroker returns a broker publishing through an in-memory redis server
+func newRedisBroker(t *testing.T) (*Broker, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+
+	rc := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rc.Close() })
+
+	b := NewBroker(WithRedis(rc))
+	t.Cleanup(func() { b.Close() })
+
+	return b, mr
+}
+
+// receive returns the next payload of the channel, it fails the test when no payload is received in time
+func receive(t *testing.T, ch <-chan []byte) string {
+	t.Helper()
+
+	select {
+	case payload := <-ch:
+		return string(payload)
+	case <-time.After(receiveTimeout):
+		require.Fail(t, "no event received")
+
+		return ""
+	}
+}
+
+func TestBrokerPublish(t *testing.T) {
+	tests := []struct {
+		name   string
+		broker func(t *testing.T) *Broker
+	}{
+		{
+			name: "in process",
+			broker: func(t *testing.T) *Broker {
+				b := NewBroker()
+				t.Cleanup(func() { b.Close() })
+
+				return b
+			},
+		},
+		{
+			name: "redis",
+			broker: func(t *testing.T) *Broker {
+				b, _ := newRedisBroker(t)
+
+				return b
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.broker(t)
+			ctx := context.Background()
+
+			first, err := b.Subscribe(ctx, "todo.created")
+			require.NoError(t, err)
+
+			second, err := b.Subscribe(ctx, "todo.created")
+			require.NoError(t, err)
+
+			other, err := b.Subscribe(ctx, "todo.deleted")
+			require.NoError(t, err)
+
+			require.NoError(t, b.Publish(ctx, "todo.created", []byte("todo_01")))
+
+			assert.Equal(t, "todo_01", receive(t, first))
+			assert.Equal(t, "todo_01", receive(t, second))
+			assert.Empty(t, other)
+		})
+	}
+}
+
+func TestBrokerRedisSubscriptions(t *testing.T) {
+	b, mr := newRedisBroker(t)
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	secondCtx, cancelSecond := context.WithCancel(context.Background())
+
+	first, err := b.Subscribe(firstCtx, "todo.created")
+	require.NoError(t, err)
+
+	second, err := b.Subscribe(secondCtx, "todo.created")
+	require.NoError(t, err)
+
+	// the subscribers of the process share the redis subscription of the topic
+	assert.Equal(t, 1, mr.PubSubNumSub("todo.created")["todo.created"])
+
+	// the subscription is kept for the remaining subscriber
+	cancelFirst()
+
+	_, ok := <-first
+	assert.False(t, ok, "the channel is closed with the context")
+
+	require.NoError(t, b.Publish(context.Background(), "todo.created", []byte("todo_01")))
+	assert.Equal(t, "todo_01", receive(t, second))
+
+	// the subscription is closed with the last subscriber
+	cancelSecond()
+
+	_, ok = <-second
+	assert.False(t, ok, "the channel is closed with the context")
+
+	assert.Eventually(t, func() bool {
+		return mr.PubSubNumSub("todo.created")["todo.created"] == 0
+	}, receiveTimeout, 10*time.Millisecond)
+}
+
+func TestBrokerRedisUnavailable(t *testing.T) {
+	b, mr := newRedisBroker(t)
+	mr.Close()
+
+	ch, err := b.Subscribe(context.Background(), "todo.created")
+	assert.Error(t, err)
+	assert.Nil(t, ch)
+
+	// the failed subscription is not kept, the next subscriber tries again
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	assert.Empty(t, b.topics)
+	assert.Empty(t, b.subscribers)
+}
+
+func TestBrokerClosed(t *testing.T) {
+	b, _ := newRedisBroker(t)
+
+	ch, err := b.Subscribe(context.Background(), "todo.created")
+	require.NoError(t, err)
+
+	require.NoError(t, b.Close())
+
+	_, ok := <-ch
+	assert.False(t, ok, "the channel is closed with the broker")
+
+	_, err = b.Subscribe(context.Background(), "todo.created")
+	assert.ErrorIs(t, err, ErrBrokerClosed)
+	assert.ErrorIs(t, b.Publish(context.Background(), "todo.created", nil), ErrBrokerClosed)
+}
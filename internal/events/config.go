@@ -0,0 +1,9 @@
+package events
+
+// Config contains the settings for the event broker
+type Config struct {
+	// UseRedis publishes events through redis pub/sub so subscribers on all server replicas receive them
+	UseRedis bool `json:"useRedis" koanf:"useRedis" default:"false"`
+	// BufferSize is the number of events buffered for each subscriber before events are dropped
+	BufferSize int `json:"bufferSize" koanf:"bufferSize" default:"16"`
+}
@@ -0,0 +1,2 @@
+// Package events contains the broker used to publish entity events to graphql subscribers
+package events
@@ -0,0 +1,53 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// apqKeyPrefix is the prefix of the redis keys used to store the automatic persisted queries
+const apqKeyPrefix = "apq:"
+
+// apqCache stores automatic persisted queries in redis so they are shared across server replicas
+type apqCache struct {
+	client *redis.Client
+	logger *zap.SugaredLogger
+	ttl    time.Duration
+}
+
+var _ graphql.Cache = &apqCache{}
+
+// newAPQCache returns a redis backed cache for automatic persisted queries
+func newAPQCache(client *redis.Client, logger *zap.SugaredLogger, ttl time.Duration) *apqCache {
+	return &apqCache{
+		client: client,
+		logger: logger,
+		ttl:    ttl,
+	}
+}
+
+// Get looks up the query of the hash in redis, the query is sent again by the client when it is not found
+func (c *apqCache) Get(ctx context.Context, key string) (any, bool) {
+	query, err := c.client.Get(ctx, apqKeyPrefix+key).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.logger.Warnw("unable to get persisted query", "hash", key, "error", err)
+		}
+
+		return nil, false
+	}
+
+	return query, true
+}
+
+// Add stores the query of the hash in redis
+func (c *apqCache) Add(ctx context.Context, key string, value any) {
+	if err := c.client.Set(ctx, apqKeyPrefix+key, value, c.ttl).Err(); err != nil {
+		c.logger.Warnw("unable to store persisted query", "hash", key, "error", err)
+	}
+}
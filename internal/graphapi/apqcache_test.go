@@ -0,0 +1,54 @@
+package graphapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestAPQCache(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	rc := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rc.Close() })
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	c := newAPQCache(rc, zap.New(core).Sugar(), time.Minute)
+
+	ctx := context.Background()
+
+	// a query that was not stored is not found and not logged
+	_, ok := c.Get(ctx, "hash")
+	assert.False(t, ok)
+
+	c.Add(ctx, "hash", "query { todos { totalCount } }")
+
+	query, ok := c.Get(ctx, "hash")
+	require.True(t, ok)
+	assert.Equal(t, "query { todos { totalCount } }", query)
+	assert.Equal(t, time.Minute, mr.TTL(apqKeyPrefix+"hash"))
+	assert.Zero(t, logs.Len())
+
+	// errors of redis are logged and the query is sent again by the client
+	mr.Close()
+
+	c.Add(ctx, "other", "query { tags { totalCount } }")
+
+	_, ok = c.Get(ctx, "other")
+	assert.False(t, ok)
+
+	messages := []string{}
+	for _, l := range logs.All() {
+		messages = append(messages, l.Message)
+	}
+
+	assert.Equal(t, []string{"unable to store persisted query", "unable to get persisted query"}, messages)
+}
@@ -0,0 +1,287 @@
+package graphapi
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// checkBatchSize returns an error when the bulk request exceeds the configured max batch size
+func (r *mutationResolver) checkBatchSize(size int) error {
+	if r.settings.MaxBatchSize > 0 && size > r.settings.MaxBatchSize {
+		return newBatchSizeExceededError(size, r.settings.MaxBatchSize)
+	}
+
+	return nil
+}
+
+// addRowErrors adds the error of each invalid row to the response, using the index of the row in the path
+// and returns a BulkValidationError if any row was invalid
+func addRowErrors(ctx context.Context, rowErrs map[int]error, total int) error {
+	if len(rowErrs) == 0 {
+		return nil
+	}
+
+	for i := range total {
+		if err, ok := rowErrs[i]; ok {
+			graphql.AddError(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i)), err)
+		}
+	}
+
+	return &BulkValidationError{
+		Invalid: len(rowErrs),
+		Total:   total,
+	}
+}
+
+// todoCSVRow is a row of a todo csv upload, the owner is set from the auth context and
+// the tags can be added once the todos are created; empty columns are left unset
+type todoCSVRow struct {
+	Name        string
+	Description string
+	Status      string
+	Priority    string
+	DueDate     string
+}
+
+// input returns the create input of the row
+func (r todoCSVRow) input(row int) (*generated.CreateTodoInput, error) {
+	data := &generated.CreateTodoInput{
+		Name:        r.Name,
+		Description: optionalColumn(r.Description),
+	}
+
+	if r.Status != "" {
+		status := todo.Status(r.Status)
+		data.Status = &status
+	}
+
+	if r.Priority != "" {
+		priority, err := strconv.Atoi(r.Priority)
+		if err != nil {
+			return nil, &RowValidationError{Row: row, Field: todo.FieldPriority, Err: err}
+		}
+
+		data.Priority = &priority
+	}
+
+	if r.DueDate != "" {
+		dueDate, err := time.Parse(time.RFC3339, r.DueDate)
+		if err != nil {
+			return nil, &RowValidationError{Row: row, Field: todo.FieldDueDate, Err: err}
+		}
+
+		data.DueDate = &dueDate
+	}
+
+	return data, nil
+}
+
+// validateBulkTodo validates each row of a bulk todo request using the ent field validators
+// and checks for duplicate names within the request
+func validateBulkTodo(input []*generated.CreateTodoInput) map[int]error {
+	rowErrs := map[int]error{}
+	names := map[string]int{}
+
+	for i, data := range input {
+		if field, err := validateTodoInput(data); err != nil {
+			rowErrs[i] = &RowValidationError{Row: i, Field: field, Err: err}
+
+			continue
+		}
+
+		if _, ok := names[data.Name]; ok {
+			rowErrs[i] = &RowValidationError{Row: i, Field: todo.FieldName, Err: newAlreadyExistsError("todo")}
+
+			continue
+		}
+
+		names[data.Name] = i
+	}
+
+	return rowErrs
+}
+
+// validateTodoInput runs the ent field validators of the fields set on the input, the same validators the
+// create builder runs before save, and returns the field that is not valid
+func validateTodoInput(data *generated.CreateTodoInput) (string, error) {
+	if err := todo.NameValidator(data.Name); err != nil {
+		return todo.FieldName, err
+	}
+
+	if data.Status != nil {
+		if err := todo.StatusValidator(*data.Status); err != nil {
+			return todo.FieldStatus, err
+		}
+	}
+
+	if data.Priority != nil {
+		if err := todo.PriorityValidator(*data.Priority); err != nil {
+			return todo.FieldPriority, err
+		}
+	}
+
+	return "", nil
+}
+
+// existingTodoNames adds an error for each valid row with the name of a todo that already exists in the
+// organization, the unique constraint would otherwise fail the whole insert without the row; the names are
+// looked up in a system context so todos the viewer can not view are found as well
+func existingTodoNames(ctx context.Context, c *generated.Client, input []*generated.CreateTodoInput, rowErrs map[int]error) error {
+	orgID, err := interceptors.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(input))
+
+	for i, data := range input {
+		if _, ok := rowErrs[i]; !ok {
+			names = append(names, data.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	existing, err := c.Todo.Query().
+		Where(todo.OwnerID(orgID), todo.NameIn(names...)).
+		Select(todo.FieldName).
+		Strings(interceptors.SkipTenant(ctx))
+	if err != nil {
+		return err
+	}
+
+	taken := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		taken[name] = true
+	}
+
+	for i, data := range input {
+		if _, ok := rowErrs[i]; !ok && taken[data.Name] {
+			rowErrs[i] = &RowValidationError{Row: i, Field: todo.FieldName, Err: newAlreadyExistsError("todo")}
+		}
+	}
+
+	return nil
+}
+
+// bulkCreateTodo uses the CreateBulk function to create multiple Todo entities
+func (r *mutationResolver) bulkCreateTodo(ctx context.Context, input []*generated.CreateTodoInput) (*TodoBulkCreatePayload, error) {
+	if err := r.checkBatchSize(len(input)); err != nil {
+		return nil, err
+	}
+
+	c := withTransactionalMutation(ctx)
+	rowErrs := validateBulkTodo(input)
+
+	if err := existingTodoNames(ctx, c, input, rowErrs); err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "todo"}, r.logger)
+	}
+
+	if err := addRowErrors(ctx, rowErrs, len(input)); err != nil {
+		return nil, err
+	}
+
+	builders := make([]*generated.TodoCreate, len(input))
+
+	for i, data := range input {
+		builders[i] = c.Todo.Create().SetInput(*data)
+	}
+
+	res, err := c.Todo.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "todo"}, r.logger)
+	}
+
+	// return response
+	return &TodoBulkCreatePayload{
+		Todos: res,
+	}, nil
+}
+
+// tagCSVRow is a row of a tag csv upload, the owner is set from the auth context; empty
+// columns are left unset
+type tagCSVRow struct {
+	Name        string
+	Color       string
+	Description string
+}
+
+// input returns the create input of the row
+func (r tagCSVRow) input(_ int) (*generated.CreateTagInput, error) {
+	return &generated.CreateTagInput{
+		Name:        r.Name,
+		Color:       optionalColumn(r.Color),
+		Description: optionalColumn(r.Description),
+	}, nil
+}
+
+// validateBulkTag validates each row of a bulk tag request using the ent field validators
+// and checks for duplicate names within the request
+func validateBulkTag(input []*generated.CreateTagInput) map[int]error {
+	rowErrs := map[int]error{}
+	names := map[string]int{}
+
+	for i, data := range input {
+		if err := tag.NameValidator(data.Name); err != nil {
+			rowErrs[i] = &RowValidationError{Row: i, Field: tag.FieldName, Err: err}
+
+			continue
+		}
+
+		if data.Color != nil {
+			if err := tag.ColorValidator(*data.Color); err != nil {
+				rowErrs[i] = &RowValidationError{Row: i, Field: tag.FieldColor, Err: err}
+
+				continue
+			}
+		}
+
+		if _, ok := names[data.Name]; ok {
+			rowErrs[i] = &RowValidationError{Row: i, Field: tag.FieldName, Err: newAlreadyExistsError("tag")}
+
+			continue
+		}
+
+		names[data.Name] = i
+	}
+
+	return rowErrs
+}
+
+// bulkCreateTag uses the CreateBulk function to create multiple Tag entities
+func (r *mutationResolver) bulkCreateTag(ctx context.Context, input []*generated.CreateTagInput) (*TagBulkCreatePayload, error) {
+	if err := r.checkBatchSize(len(input)); err != nil {
+		return nil, err
+	}
+
+	if err := addRowErrors(ctx, validateBulkTag(input), len(input)); err != nil {
+		return nil, err
+	}
+
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.TagCreate, len(input))
+
+	for i, data := range input {
+		builders[i] = c.Tag.Create().SetInput(*data)
+	}
+
+	res, err := c.Tag.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "tag"}, r.logger)
+	}
+
+	// return response
+	return &TagBulkCreatePayload{
+		Tags: res,
+	}, nil
+}
@@ -0,0 +1,310 @@
+package graphapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/config"
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestTodoCSVRowInput(t *testing.T) {
+	status := todo.StatusBLOCKED
+	priority := 2
+	dueDate := time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)
+	description := "buy milk"
+
+	tests := []struct {
+		name      string
+		row       todoCSVRow
+		want      *generated.CreateTodoInput
+		wantField string
+	}{
+		{
+			name: "all columns",
+			row: todoCSVRow{
+				Name:        "groceries",
+				Description: "buy milk",
+				Status:      "BLOCKED",
+				Priority:    "2",
+				DueDate:     "2024-10-18T12:00:00Z",
+			},
+			want: &generated.CreateTodoInput{
+				Name:        "groceries",
+				Description: &description,
+				Status:      &status,
+				Priority:    &priority,
+				DueDate:     &dueDate,
+			},
+		},
+		{
+			name: "empty columns are left unset",
+			row:  todoCSVRow{Name: "groceries"},
+			want: &generated.CreateTodoInput{Name: "groceries"},
+		},
+		{
+			name:      "invalid priority",
+			row:       todoCSVRow{Name: "groceries", Priority: "high"},
+			wantField: todo.FieldPriority,
+		},
+		{
+			name:      "invalid due date",
+			row:       todoCSVRow{Name: "groceries", DueDate: "tomorrow"},
+			wantField: todo.FieldDueDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.row.input(3)
+
+			if tt.wantField != "" {
+				var rowErr *RowValidationError
+
+				require.ErrorAs(t, err, &rowErr)
+				assert.Equal(t, 3, rowErr.Row)
+				assert.Equal(t, tt.wantField, rowErr.Field)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateBulkTodo(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      []*generated.CreateTodoInput
+		wantFields map[int]string
+	}{
+		{
+			name: "valid",
+			input: []*generated.CreateTodoInput{
+				{Name: "groceries"},
+				{Name: "laundry"},
+			},
+			wantFields: map[int]string{},
+		},
+		{
+			name: "empty name",
+			input: []*generated.CreateTodoInput{
+				{Name: ""},
+				{Name: "laundry"},
+			},
+			wantFields: map[int]string{0: todo.FieldName},
+		},
+		{
+			name: "duplicate names",
+			input: []*generated.CreateTodoInput{
+				{Name: "groceries"},
+				{Name: "groceries"},
+				{Name: "laundry"},
+				{Name: "groceries"},
+			},
+			wantFields: map[int]string{1: todo.FieldName, 3: todo.FieldName},
+		},
+		{
+			name: "invalid status and priority",
+			input: []*generated.CreateTodoInput{
+				{Name: "groceries", Status: func() *todo.Status { s := todo.Status("LATER"); return &s }()},
+				{Name: "laundry", Priority: func() *int { p := -1; return &p }()},
+				{Name: "dishes", Status: func() *todo.Status { s := todo.StatusDONE; return &s }()},
+			},
+			wantFields: map[int]string{0: todo.FieldStatus, 1: todo.FieldPriority},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rowErrs := validateBulkTodo(tt.input)
+
+			require.Len(t, rowErrs, len(tt.wantFields))
+
+			for row, field := range tt.wantFields {
+				var rowErr *RowValidationError
+
+				require.ErrorAs(t, rowErrs[row], &rowErr)
+				assert.Equal(t, row, rowErr.Row)
+				assert.Equal(t, field, rowErr.Field)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBulkData(t *testing.T) {
+	tests := []struct {
+		name      string
+		csv       string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "rows",
+			csv:       "Name,Description,Priority\ngroceries,buy milk,1\nlaundry,,\n",
+			wantNames: []string{"groceries", "laundry"},
+		},
+		{
+			name:      "columns in any order",
+			csv:       "Priority,Name\n1,groceries\n",
+			wantNames: []string{"groceries"},
+		},
+		{
+			name:    "invalid row",
+			csv:     "Name,Priority\ngroceries,1\nlaundry,high\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid csv",
+			csv:     "Name,Priority\n\"groceries,1\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := unmarshalBulkData[todoCSVRow, generated.CreateTodoInput](graphql.Upload{File: strings.NewReader(tt.csv)})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCSV)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, d := range data {
+				names = append(names, d.Name)
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+// bulkError is the path and code of an error of a bulk mutation
+type bulkError struct {
+	path []any
+	code string
+}
+
+func TestCreateBulkTodo(t *testing.T) {
+	f := newTestFixture(t)
+
+	f.handler = NewResolver(f.client).WithSettings(config.GraphQL{MaxBatchSize: 3}).Handler(false)
+
+	// names only conflict with the todos of the organization that are not deleted
+	archived := f.client.Todo.Create().SetName("archive").SaveX(testutils.UserContext(f.member.ID, f.org.ID))
+	f.client.Todo.DeleteOne(archived).ExecX(testutils.UserContext(f.member.ID, f.org.ID))
+	f.client.Todo.Create().SetName("invoices").SaveX(testutils.UserContext(f.outsider.ID, f.otherOrg.ID))
+
+	const query = `mutation($input: [CreateTodoInput!]) {
+		createBulkTodo(input: $input) { todos { name } }
+	}`
+
+	tests := []struct {
+		name       string
+		input      []map[string]any
+		wantErrors []bulkError
+		wantNames  []string
+	}{
+		{
+			name:      "todos",
+			input:     []map[string]any{{"name": "groceries"}, {"name": "laundry", "priority": 2}},
+			wantNames: []string{"groceries", "laundry"},
+		},
+		{
+			name:  "invalid rows",
+			input: []map[string]any{{"name": "dishes"}, {"name": ""}, {"name": "dishes"}},
+			wantErrors: []bulkError{
+				{path: []any{"createBulkTodo", float64(1)}, code: ErrCodeBadUserInput},
+				{path: []any{"createBulkTodo", float64(2)}, code: ErrCodeConflict},
+				{path: []any{"createBulkTodo"}, code: ErrCodeBadUserInput},
+			},
+		},
+		{
+			name:  "name of a todo of the organization",
+			input: []map[string]any{{"name": "paperwork"}, {"name": "member todo"}},
+			wantErrors: []bulkError{
+				{path: []any{"createBulkTodo", float64(1)}, code: ErrCodeConflict},
+				{path: []any{"createBulkTodo"}, code: ErrCodeBadUserInput},
+			},
+		},
+		{
+			name:      "names of a deleted todo and of a todo of another organization",
+			input:     []map[string]any{{"name": "archive"}, {"name": "invoices"}},
+			wantNames: []string{"archive", "invoices"},
+		},
+		{
+			name:  "invalid priority",
+			input: []map[string]any{{"name": "taxes", "priority": -1}},
+			wantErrors: []bulkError{
+				{path: []any{"createBulkTodo", float64(0)}, code: ErrCodeBadUserInput},
+				{path: []any{"createBulkTodo"}, code: ErrCodeBadUserInput},
+			},
+		},
+		{
+			name:  "batch size exceeded",
+			input: []map[string]any{{"name": "a"}, {"name": "b"}, {"name": "c"}, {"name": "d"}},
+			wantErrors: []bulkError{
+				{path: []any{"createBulkTodo"}, code: ErrCodeBadUserInput},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := f.client.Todo.Query().CountX(testutils.UserContext(f.member.ID, f.org.ID))
+
+			rec := f.send(t, f.member, f.org, query, map[string]any{"input": tt.input})
+
+			var res struct {
+				Data   map[string]any `json:"data"`
+				Errors []struct {
+					Path       []any          `json:"path"`
+					Extensions map[string]any `json:"extensions"`
+				} `json:"errors"`
+			}
+
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+			if tt.wantErrors != nil {
+				// each invalid row is reported at its index, followed by the error of the mutation
+				got := []bulkError{}
+				for _, e := range res.Errors {
+					code, _ := e.Extensions[codeExtension].(string)
+					got = append(got, bulkError{path: e.Path, code: code})
+				}
+
+				assert.ElementsMatch(t, tt.wantErrors, got)
+
+				// no todos are created when any row is invalid
+				assert.Equal(t, before, f.client.Todo.Query().CountX(testutils.UserContext(f.member.ID, f.org.ID)))
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			todos := res.Data["createBulkTodo"].(map[string]any)["todos"].([]any)
+
+			names := []string{}
+			for _, td := range todos {
+				names = append(names, td.(map[string]any)["name"].(string))
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package graphapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/redis/go-redis/v9"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+
+	"github.com/datumforge/go-template/config"
+)
+
+const (
+	// costLimitKeyPrefix is the prefix of the redis keys used to store the token bucket of each principal
+	costLimitKeyPrefix = "graphql:cost:"
+	// rateLimitExtension is the key of the rate limit details in the graphql error extensions
+	rateLimitExtension = "rateLimit"
+
+	// headerRateLimitLimit is the header containing the budget of the principal
+	headerRateLimitLimit = "X-RateLimit-Limit"
+	// headerRateLimitRemaining is the header containing the remaining budget of the principal
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	// headerRateLimitReset is the header containing the number of seconds until the operation can be retried
+	headerRateLimitReset = "X-RateLimit-Reset"
+)
+
+// tokenBucketScript refills the bucket of the principal based on the time elapsed since the last
+// operation and takes the cost of the operation when the bucket contains enough tokens
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local cost = tonumber(ARGV[4])
+local ttl = tonumber(ARGV[5])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+
+tokens = math.min(capacity, tokens + (math.max(0, now - ts) / 1000) * rate)
+
+local allowed = 0
+if tokens >= cost then
+	tokens = tokens - cost
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tokens, "ts", now)
+redis.call("PEXPIRE", KEYS[1], ttl)
+
+return {allowed, math.floor(tokens)}
+`)
+
+// costLimit is a graphql extension that charges the complexity of each operation against
+// a token bucket of the principal (authenticated user or client ip) stored in redis
+type costLimit struct {
+	client *redis.Client
+	logger *zap.SugaredLogger
+
+	// budget is the capacity of the token bucket
+	budget int
+	// refillRate is the number of tokens added to the bucket per second
+	refillRate float64
+	// failClosed rejects the operations when the bucket can not be read from redis
+	failClosed bool
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &costLimit{}
+
+// ExtensionName returns the name of the extension
+func (c *costLimit) ExtensionName() string {
+	return "CostLimit"
+}
+
+// Validate stores the executable schema used to calculate the complexity
+func (c *costLimit) Validate(schema graphql.ExecutableSchema) error {
+	c.es = schema
+
+	return nil
+}
+
+// MutateOperationContext charges the complexity of the operation to the principal and returns an error
+// with the rate limit details when the budget of the principal is exhausted
+func (c *costLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	// reuse the complexity calculated by the query limits when available
+	cost := 0
+	if stats, ok := rc.Stats.GetExtension(complexityStatsExtension).(*extension.ComplexityStats); ok {
+		cost = stats.Complexity
+	} else {
+		cost = complexity.Calculate(c.es, op, rc.Variables)
+	}
+
+	principal := rateLimitPrincipal(ctx)
+
+	allowed, remaining, err := c.take(ctx, principal, cost)
+	if err != nil {
+		c.logger.Warnw("unable to check graphql cost limit", "principal", principal, "error", err, "fail_closed", c.failClosed)
+
+		if !c.failClosed {
+			return nil
+		}
+
+		rejectedOperations.WithLabelValues("cost").Inc()
+
+		gqlErr := gqlerror.Errorf("the rate limit budget can not be checked, retry the operation later")
+		errcode.Set(gqlErr, ErrCodeRateLimited)
+
+		return gqlErr
+	}
+
+	reset := 0
+	if !allowed {
+		reset = int(math.Ceil(float64(cost-remaining) / c.refillRate))
+	}
+
+	setRateLimitHeaders(ctx, c.budget, remaining, reset)
+
+	if allowed {
+		return nil
+	}
+
+	rejectedOperations.WithLabelValues("cost").Inc()
+
+	gqlErr := gqlerror.Errorf("operation cost of %d exceeds the remaining rate limit budget of %d", cost, remaining)
+	errcode.Set(gqlErr, ErrCodeRateLimited)
+
+	gqlErr.Extensions[rateLimitExtension] = map[string]interface{}{
+		headerRateLimitLimit:     c.budget,
+		headerRateLimitRemaining: remaining,
+		headerRateLimitReset:     reset,
+		"cost":                   cost,
+	}
+
+	return gqlErr
+}
+
+// CheckCostLimit returns an error when the cost limit is enabled but can not be enforced, the server refuses to
+// start instead of serving without the limit; the client is nil when redis is disabled
+func CheckCostLimit(ctx context.Context, settings config.CostLimit, rc *redis.Client) error {
+	if !settings.Enabled {
+		return nil
+	}
+
+	if settings.Budget <= 0 || settings.RefillRate <= 0 {
+		return ErrInvalidCostLimit
+	}
+
+	if rc == nil {
+		return fmt.Errorf("%w: redis is disabled", ErrCostLimitUnavailable)
+	}
+
+	if err := rc.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrCostLimitUnavailable, err)
+	}
+
+	return nil
+}
+
+// take removes the cost from the token bucket of the principal and returns whether the operation
+// is allowed along with the remaining budget
+func (c *costLimit) take(ctx context.Context, principal string, cost int) (bool, int, error) {
+	// keep the bucket until it would have been refilled completely
+	if c.client == nil {
+		return false, 0, ErrCostLimitUnavailable
+	}
+
+	ttl := time.Duration(float64(c.budget)/c.refillRate*float64(time.Second)) + time.Second
+
+	res, err := tokenBucketScript.Run(ctx, c.client, []string{costLimitKeyPrefix + principal},
+		c.budget, c.refillRate, time.Now().UnixMilli(), cost, ttl.Milliseconds()).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return res[0] == 1, int(res[1]), nil
+}
+
+// rateLimitPrincipal returns the principal the operation is charged to, the authenticated user
+// when available, otherwise the ip address of the client
+func rateLimitPrincipal(ctx context.Context) string {
+	// the subject is read as is, GetUserIDFromContext rejects any user id that is not a bare ulid
+	if au, err := auth.GetAuthenticatedUserContext(ctx); err == nil && au.SubjectID != "" {
+		return "user:" + au.SubjectID
+	}
+
+	ec, err := echocontext.EchoContextFromContext(ctx)
+	if err != nil {
+		return "ip:unknown"
+	}
+
+	return "ip:" + ec.RealIP()
+}
+
+// setRateLimitHeaders adds the rate limit details to the response headers
+func setRateLimitHeaders(ctx context.Context, limit, remaining, reset int) {
+	ec, err := echocontext.EchoContextFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	h := ec.Response().Header()
+	h.Set(headerRateLimitLimit, strconv.Itoa(limit))
+	h.Set(headerRateLimitRemaining, strconv.Itoa(remaining))
+	h.Set(headerRateLimitReset, strconv.Itoa(reset))
+}
@@ -0,0 +1,249 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/config"
+)
+
+// costQuery is the operation charged in the cost limit tests
+const costQuery = `{ todos { totalCount edges { node { id name } } } }`
+
+// withCostLimit replaces the handler of the fixture with one charging the operations to budgets stored in the redis server
+func withCostLimit(t *testing.T, f *testFixture, mr *miniredis.Miniredis, settings config.CostLimit) {
+	t.Helper()
+
+	rc := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { rc.Close() })
+
+	settings.Enabled = true
+
+	f.handler = NewResolver(f.client).
+		WithLogger(zap.NewNop().Sugar()).
+		WithSettings(config.GraphQL{CostLimit: settings}).
+		WithRedisClient(rc).
+		Handler(false)
+}
+
+// rateLimitHeaders returns the limit, remaining budget and reset of the response headers
+func rateLimitHeaders(t *testing.T, rec *httptest.ResponseRecorder) (limit, remaining, reset int) {
+	t.Helper()
+
+	var err error
+
+	limit, err = strconv.Atoi(rec.Header().Get(headerRateLimitLimit))
+	require.NoError(t, err)
+
+	remaining, err = strconv.Atoi(rec.Header().Get(headerRateLimitRemaining))
+	require.NoError(t, err)
+
+	reset, err = strconv.Atoi(rec.Header().Get(headerRateLimitReset))
+	require.NoError(t, err)
+
+	return limit, remaining, reset
+}
+
+func TestCheckCostLimit(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	rc := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rc.Close() })
+
+	down := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	t.Cleanup(func() { down.Close() })
+
+	enabled := config.CostLimit{Enabled: true, Budget: 100, RefillRate: 10}
+
+	tests := []struct {
+		name     string
+		settings config.CostLimit
+		client   *redis.Client
+		wantErr  error
+	}{
+		{
+			name:     "disabled without redis",
+			settings: config.CostLimit{Budget: 100, RefillRate: 10},
+		},
+		{
+			name:     "enabled with redis",
+			settings: enabled,
+			client:   rc,
+		},
+		{
+			name:     "redis disabled",
+			settings: enabled,
+			wantErr:  ErrCostLimitUnavailable,
+		},
+		{
+			name:     "redis unreachable",
+			settings: enabled,
+			client:   down,
+			wantErr:  ErrCostLimitUnavailable,
+		},
+		{
+			name:     "without a refill rate",
+			settings: config.CostLimit{Enabled: true, Budget: 100},
+			client:   rc,
+			wantErr:  ErrInvalidCostLimit,
+		},
+		{
+			name:     "without a budget",
+			settings: config.CostLimit{Enabled: true, RefillRate: 10},
+			client:   rc,
+			wantErr:  ErrInvalidCostLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckCostLimit(context.Background(), tt.settings, tt.client)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCostLimit(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	// the cost of the operation is the budget spent by the first request
+	f := newTestFixture(t)
+	withCostLimit(t, f, mr, config.CostLimit{Budget: 1000, RefillRate: 1})
+
+	rec := f.send(t, f.member, f.org, costQuery, nil)
+	_, remaining, _ := rateLimitHeaders(t, rec)
+
+	cost := 1000 - remaining
+	require.Positive(t, cost)
+
+	mr.FlushAll()
+
+	// the budget allows two operations, refilled by one token a second
+	withCostLimit(t, f, mr, config.CostLimit{Budget: 2 * cost, RefillRate: 1})
+	key := costLimitKeyPrefix + "user:" + f.member.ID
+
+	tests := []struct {
+		name          string
+		setup         func()
+		wantRemaining int
+		wantLimited   bool
+	}{
+		{
+			name:          "first operation",
+			wantRemaining: cost,
+		},
+		{
+			name:          "budget spent",
+			wantRemaining: 0,
+		},
+		{
+			name:        "budget exceeded",
+			wantLimited: true,
+		},
+		{
+			name: "budget refilled",
+			setup: func() {
+				// the bucket was last charged long enough ago to be refilled completely
+				mr.HSet(key, "ts", strconv.FormatInt(time.Now().Add(-time.Hour).UnixMilli(), 10))
+			},
+			wantRemaining: cost,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			rec := f.send(t, f.member, f.org, costQuery, nil)
+
+			var res graphResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+			limit, remaining, reset := rateLimitHeaders(t, rec)
+			assert.Equal(t, 2*cost, limit)
+
+			if !tt.wantLimited {
+				require.Empty(t, res.Errors)
+				assert.Equal(t, tt.wantRemaining, remaining)
+				assert.Zero(t, reset)
+
+				return
+			}
+
+			require.Len(t, res.Errors, 1)
+			assert.Equal(t, ErrCodeRateLimited, res.errorCode())
+			assert.Nil(t, res.Data)
+
+			// the operation can be retried once the missing tokens are refilled
+			assert.Equal(t, cost-remaining, reset)
+
+			details, ok := res.Errors[0].Extensions[rateLimitExtension].(map[string]any)
+			require.True(t, ok)
+			assert.EqualValues(t, cost, details["cost"])
+			assert.EqualValues(t, remaining, details[headerRateLimitRemaining])
+			assert.EqualValues(t, reset, details[headerRateLimitReset])
+		})
+	}
+
+	t.Run("budget of another user", func(t *testing.T) {
+		res := f.query(t, f.admin, f.org, costQuery, nil)
+		assert.Empty(t, res.Errors)
+	})
+}
+
+func TestCostLimitRedisUnavailable(t *testing.T) {
+	tests := []struct {
+		name        string
+		failClosed  bool
+		wantLimited bool
+	}{
+		{
+			name: "fail open",
+		},
+		{
+			name:        "fail closed",
+			failClosed:  true,
+			wantLimited: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+
+			f := newTestFixture(t)
+			withCostLimit(t, f, mr, config.CostLimit{Budget: 1000, RefillRate: 1, FailClosed: tt.failClosed})
+
+			mr.Close()
+
+			res := f.query(t, f.member, f.org, costQuery, nil)
+
+			if tt.wantLimited {
+				assert.Equal(t, ErrCodeRateLimited, res.errorCode())
+				assert.Nil(t, res.Data)
+
+				return
+			}
+
+			assert.Empty(t, res.Errors)
+		})
+	}
+}
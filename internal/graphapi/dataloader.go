@@ -0,0 +1,459 @@
+package graphapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+)
+
+const (
+	// defaultLoaderWait is the time a loader waits for more keys after the first key of a batch
+	defaultLoaderWait = 2 * time.Millisecond
+	// defaultLoaderMaxBatch is the maximum number of keys fetched in a single batch
+	defaultLoaderMaxBatch = 100
+)
+
+// loaderBatchSize records the number of keys fetched in each batch by the dataloaders
+var loaderBatchSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "graphql_dataloader_batch_size",
+	Help:    "The number of keys fetched in a single batch by the graphql dataloaders",
+	Buckets: []float64{1, 2, 5, 10, 25, 50, 100},
+}, []string{"loader"})
+
+// loadersCtxKey is the context key for the per request dataloaders
+type loadersCtxKey struct{}
+
+// Loaders contains the dataloaders for a single request, they batch the lookups by ID for
+// each entity type and cache the results until the end of the request; there is a loader
+// for each of the node tables so the nodes of every type are batched
+type Loaders struct {
+	Organization  *dataLoader[string, *ent.Organization]
+	User          *dataLoader[string, *ent.User]
+	OrgMembership *dataLoader[string, *ent.OrgMembership]
+	Tag           *dataLoader[string, *ent.Tag]
+	Todo          *dataLoader[string, *ent.Todo]
+	TodoHistory   *dataLoader[string, *ent.TodoHistory]
+}
+
+// newLoaders returns a new set of dataloaders fetching the batches with the context of the operation,
+// a new set is created for each operation so results are never shared between requests
+func newLoaders(ctx context.Context) *Loaders {
+	return &Loaders{
+		Organization: newDataLoader(ctx, "organization", func(ctx context.Context, ids []string) (map[string]*ent.Organization, error) {
+			orgs, err := withTransactionalMutation(ctx).Organization.Query().Where(organization.IDIn(ids...)).All(ctx)
+
+			return mapByID(orgs, err, func(o *ent.Organization) string { return o.ID })
+		}),
+		User: newDataLoader(ctx, "user", func(ctx context.Context, ids []string) (map[string]*ent.User, error) {
+			users, err := withTransactionalMutation(ctx).User.Query().Where(user.IDIn(ids...)).All(ctx)
+
+			return mapByID(users, err, func(u *ent.User) string { return u.ID })
+		}),
+		OrgMembership: newDataLoader(ctx, "orgmembership", func(ctx context.Context, ids []string) (map[string]*ent.OrgMembership, error) {
+			memberships, err := withTransactionalMutation(ctx).OrgMembership.Query().Where(orgmembership.IDIn(ids...)).All(ctx)
+
+			return mapByID(memberships, err, func(om *ent.OrgMembership) string { return om.ID })
+		}),
+		Tag: newDataLoader(ctx, "tag", func(ctx context.Context, ids []string) (map[string]*ent.Tag, error) {
+			tags, err := withTransactionalMutation(ctx).Tag.Query().Where(tag.IDIn(ids...)).All(ctx)
+
+			return mapByID(tags, err, func(t *ent.Tag) string { return t.ID })
+		}),
+		Todo: newDataLoader(ctx, "todo", func(ctx context.Context, ids []string) (map[string]*ent.Todo, error) {
+			todos, err := withTransactionalMutation(ctx).Todo.Query().Where(todo.IDIn(ids...)).All(ctx)
+
+			return mapByID(todos, err, func(t *ent.Todo) string { return t.ID })
+		}),
+		TodoHistory: newDataLoader(ctx, "todohistory", func(ctx context.Context, ids []string) (map[string]*ent.TodoHistory, error) {
+			histories, err := withTransactionalMutation(ctx).TodoHistory.Query().Where(todohistory.IDIn(ids...)).All(ctx)
+
+			return mapByID(histories, err, func(th *ent.TodoHistory) string { return th.ID })
+		}),
+	}
+}
+
+// mapByID returns the objects fetched by a dataloader by their ID
+func mapByID[V any](objs []V, err error, id func(V) string) (map[string]V, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]V, len(objs))
+	for _, o := range objs {
+		res[id(o)] = o
+	}
+
+	return res, nil
+}
+
+// withLoaders returns a new context with the dataloaders
+func withLoaders(ctx context.Context, l *Loaders) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, l)
+}
+
+// loadersFromContext returns the dataloaders of the request, when the context does not contain dataloaders,
+// e.g. a resolver called outside of the handler, new loaders are created with the client of the context so the
+// lookups still work but their results are not cached for the rest of the request
+func loadersFromContext(ctx context.Context) *Loaders {
+	l, ok := ctx.Value(loadersCtxKey{}).(*Loaders)
+	if !ok {
+		return newLoaders(ctx)
+	}
+
+	return l
+}
+
+// injectLoaders adds new dataloaders to the context of each response, it runs after the transactioner
+// so the batches are fetched with the (transactional) client, viewer and tenant of the operation
+func injectLoaders(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(withLoaders(ctx, newLoaders(ctx)))
+}
+
+// dataLoader batches the lookups of keys made within a short window into a single fetch and caches
+// the results of each key; the batches are fetched with the context of the operation, never with the
+// context of one of the callers
+type dataLoader[K comparable, V any] struct {
+	ctx      context.Context
+	name     string
+	fetch    func(ctx context.Context, keys []K) (map[K]V, error)
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	cache map[K]*loaderResult[V]
+	batch *loaderBatch[K, V]
+}
+
+// loaderResult is the result of a single key, done is closed once the value or error is set
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// loaderBatch contains the pending keys of a batch
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	results []*loaderResult[V]
+	timer   *time.Timer
+}
+
+// newDataLoader returns a dataloader using the fetch function to lookup a batch of keys with the context
+// of the operation, keys missing from the returned map result in a not found error
+func newDataLoader[K comparable, V any](ctx context.Context, name string, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *dataLoader[K, V] {
+	return &dataLoader[K, V]{
+		ctx:      ctx,
+		name:     name,
+		fetch:    fetch,
+		wait:     defaultLoaderWait,
+		maxBatch: defaultLoaderMaxBatch,
+		cache:    map[K]*loaderResult[V]{},
+	}
+}
+
+// Load returns the value of the key, the lookup is batched with the other keys loaded within the
+// wait window; the context only bounds the wait of the caller, the batch is fetched for the other
+// callers when it is canceled
+func (l *dataLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	res, full := l.enqueue(key)
+	if full != nil {
+		l.run(full)
+	}
+
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var v V
+
+		return v, ctx.Err()
+	}
+}
+
+// LoadAll returns the values of the keys in the same order, along with an error for each key
+// that could not be loaded; the keys are fetched right away on the calling goroutine, along with
+// the keys already pending, instead of waiting for more keys
+func (l *dataLoader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, []error) {
+	results := make([]*loaderResult[V], len(keys))
+
+	for i, key := range keys {
+		var full *loaderBatch[K, V]
+
+		results[i], full = l.enqueue(key)
+		if full != nil {
+			l.run(full)
+		}
+	}
+
+	l.mu.Lock()
+	pending := l.batch
+	l.batch = nil
+	l.mu.Unlock()
+
+	if pending != nil {
+		pending.timer.Stop()
+		l.run(pending)
+	}
+
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+
+	for i, res := range results {
+		select {
+		case <-res.done:
+			values[i], errs[i] = res.value, res.err
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+		}
+	}
+
+	return values, errs
+}
+
+// Prime adds the value to the cache, replacing any previously loaded value of the key
+func (l *dataLoader[K, V]) Prime(key K, value V) {
+	res := &loaderResult[V]{done: make(chan struct{}), value: value}
+	close(res.done)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cache[key] = res
+}
+
+// Clear removes the key from the cache so the next load fetches it again
+func (l *dataLoader[K, V]) Clear(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.cache, key)
+}
+
+// enqueue returns the cached result of the key or adds the key to the pending batch, the batch
+// is returned once it reaches the max batch size so the caller can run it
+func (l *dataLoader[K, V]) enqueue(key K) (*loaderResult[V], *loaderBatch[K, V]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if res, ok := l.cache[key]; ok {
+		return res, nil
+	}
+
+	res := &loaderResult[V]{done: make(chan struct{})}
+	l.cache[key] = res
+
+	if l.batch == nil {
+		// the wait window starts with the first key and is never extended, so a key waits at most
+		// the window before it is fetched
+		batch := &loaderBatch[K, V]{}
+		batch.timer = time.AfterFunc(l.wait, func() { l.dispatch(batch) })
+
+		l.batch = batch
+	}
+
+	l.batch.keys = append(l.batch.keys, key)
+	l.batch.results = append(l.batch.results, res)
+
+	if len(l.batch.keys) >= l.maxBatch {
+		batch := l.batch
+		l.batch = nil
+
+		batch.timer.Stop()
+
+		return res, batch
+	}
+
+	return res, nil
+}
+
+// dispatch runs the batch once the wait window has passed, unless it was already dispatched
+// because it reached the max batch size or its keys were loaded by LoadAll
+func (l *dataLoader[K, V]) dispatch(batch *loaderBatch[K, V]) {
+	l.mu.Lock()
+
+	if l.batch != batch {
+		l.mu.Unlock()
+
+		return
+	}
+
+	l.batch = nil
+	l.mu.Unlock()
+
+	l.run(batch)
+}
+
+// run fetches the keys of the batch and sets the result of each key
+func (l *dataLoader[K, V]) run(batch *loaderBatch[K, V]) {
+	loaderBatchSize.WithLabelValues(l.name).Observe(float64(len(batch.keys)))
+
+	values, err := l.fetch(l.ctx, batch.keys)
+
+	if err != nil {
+		// do not cache errors, the next load fetches the keys again; the keys are removed before
+		// the results are set so a caller retrying on the error never gets the cached error
+		l.mu.Lock()
+
+		for i, key := range batch.keys {
+			if l.cache[key] == batch.results[i] {
+				delete(l.cache, key)
+			}
+		}
+
+		l.mu.Unlock()
+	}
+
+	for i, key := range batch.keys {
+		res := batch.results[i]
+
+		switch v, ok := values[key]; {
+		case err != nil:
+			res.err = err
+		case !ok:
+			res.err = newNotFoundError(l.name)
+		default:
+			res.value = v
+		}
+
+		close(res.done)
+	}
+}
+
+// loadNode returns the node of the global ID using the dataloader of its type
+func loadNode(ctx context.Context, id string) (ent.Noder, error) {
+	table, err := nodeType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	nl, err := loadersFromContext(ctx).nodeLoader(table)
+	if err != nil {
+		return nil, err
+	}
+
+	return nl.loadNode(ctx, id)
+}
+
+// loadNodes returns the nodes of the global IDs in the same order, the IDs are batched with the
+// dataloader of each type and the types are loaded one after the other, as the queries share the
+// transactional client of the request; an error is added to the response for each ID that could
+// not be loaded
+func loadNodes(ctx context.Context, ids []string) []ent.Noder {
+	noders := make([]ent.Noder, len(ids))
+	errs := make([]error, len(ids))
+
+	var tables []string
+
+	tableIDs := map[string][]string{}
+	tableIdx := map[string][]int{}
+
+	for i, id := range ids {
+		table, err := nodeType(ctx, id)
+		if err != nil {
+			errs[i] = err
+
+			continue
+		}
+
+		if _, ok := tableIDs[table]; !ok {
+			tables = append(tables, table)
+		}
+
+		tableIDs[table] = append(tableIDs[table], id)
+		tableIdx[table] = append(tableIdx[table], i)
+	}
+
+	for _, table := range tables {
+		nl, err := loadersFromContext(ctx).nodeLoader(table)
+		if err != nil {
+			for _, i := range tableIdx[table] {
+				errs[i] = err
+			}
+
+			continue
+		}
+
+		loaded, loadErrs := nl.loadNodes(ctx, tableIDs[table])
+
+		for j, i := range tableIdx[table] {
+			noders[i], errs[i] = loaded[j], loadErrs[j]
+		}
+	}
+
+	for i, err := range errs {
+		if err != nil {
+			graphql.AddError(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i)), err)
+		}
+	}
+
+	return noders
+}
+
+// nodeLoader loads the nodes of a single table with the dataloader of its type
+type nodeLoader interface {
+	loadNode(ctx context.Context, id string) (ent.Noder, error)
+	loadNodes(ctx context.Context, ids []string) ([]ent.Noder, []error)
+}
+
+// nodeLoader returns the loader of the nodes of the table
+func (l *Loaders) nodeLoader(table string) (nodeLoader, error) {
+	switch table {
+	case organization.Table:
+		return noderLoader[*ent.Organization]{l.Organization}, nil
+	case user.Table:
+		return noderLoader[*ent.User]{l.User}, nil
+	case orgmembership.Table:
+		return noderLoader[*ent.OrgMembership]{l.OrgMembership}, nil
+	case tag.Table:
+		return noderLoader[*ent.Tag]{l.Tag}, nil
+	case todo.Table:
+		return noderLoader[*ent.Todo]{l.Todo}, nil
+	case todohistory.Table:
+		return noderLoader[*ent.TodoHistory]{l.TodoHistory}, nil
+	default:
+		return nil, fmt.Errorf("%w: no loader for table %s", ErrInvalidNodeID, table)
+	}
+}
+
+// noderLoader returns the values of a dataloader as nodes
+type noderLoader[V ent.Noder] struct {
+	*dataLoader[string, V]
+}
+
+// loadNode returns the node of the ID
+func (nl noderLoader[V]) loadNode(ctx context.Context, id string) (ent.Noder, error) {
+	v, err := nl.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// loadNodes returns the nodes of the IDs in the same order, the nodes that could not be loaded are nil
+func (nl noderLoader[V]) loadNodes(ctx context.Context, ids []string) ([]ent.Noder, []error) {
+	values, errs := nl.LoadAll(ctx, ids)
+
+	noders := make([]ent.Noder, len(ids))
+
+	for i, v := range values {
+		if errs[i] == nil {
+			noders[i] = v
+		}
+	}
+
+	return noders, errs
+}
@@ -0,0 +1,271 @@
+package graphapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/intercept"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+// testLoader is a dataloader of the keys in values that records the keys of each fetched batch
+type testLoader struct {
+	*dataLoader[string, string]
+
+	mu      sync.Mutex
+	batches [][]string
+}
+
+// newTestLoader returns a dataloader of the keys in values, the fetch fails when the context of the loader is canceled
+func newTestLoader(ctx context.Context, values map[string]string, wait time.Duration, maxBatch int) *testLoader {
+	tl := &testLoader{}
+
+	tl.dataLoader = newDataLoader(ctx, "todo", func(ctx context.Context, keys []string) (map[string]string, error) {
+		tl.mu.Lock()
+		tl.batches = append(tl.batches, keys)
+		tl.mu.Unlock()
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		res := map[string]string{}
+
+		for _, k := range keys {
+			if v, ok := values[k]; ok {
+				res[k] = v
+			}
+		}
+
+		return res, nil
+	})
+	tl.wait = wait
+	tl.maxBatch = maxBatch
+
+	return tl
+}
+
+// batchSizes returns the number of keys of each fetched batch
+func (tl *testLoader) batchSizes() []int {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	sizes := make([]int, len(tl.batches))
+	for i, b := range tl.batches {
+		sizes[i] = len(b)
+	}
+
+	return sizes
+}
+
+// loadConcurrently loads each key on its own goroutine and returns the value and error of each key
+func loadConcurrently(l *dataLoader[string, string], ctx context.Context, keys []string) ([]string, []error) {
+	values := make([]string, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+
+	for i, key := range keys {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			values[i], errs[i] = l.Load(ctx, key)
+		}()
+	}
+
+	wg.Wait()
+
+	return values, errs
+}
+
+func TestDataLoaderLoad(t *testing.T) {
+	values := map[string]string{}
+	for i := range 50 {
+		values[fmt.Sprintf("todo_%d", i)] = fmt.Sprintf("todo %d", i)
+	}
+
+	keys := func(n int) []string {
+		keys := make([]string, n)
+		for i := range keys {
+			keys[i] = fmt.Sprintf("todo_%d", i)
+		}
+
+		return keys
+	}
+
+	tests := []struct {
+		name        string
+		keys        []string
+		wait        time.Duration
+		maxBatch    int
+		wantBatches []int
+	}{
+		{
+			name:        "keys loaded within the wait window",
+			keys:        keys(5),
+			wait:        100 * time.Millisecond,
+			maxBatch:    100,
+			wantBatches: []int{5},
+		},
+		{
+			name:        "full batches are fetched without waiting",
+			keys:        keys(30),
+			wait:        time.Hour,
+			maxBatch:    10,
+			wantBatches: []int{10, 10, 10},
+		},
+		{
+			name:        "duplicate keys are fetched once",
+			keys:        []string{"todo_1", "todo_2", "todo_1", "todo_2", "todo_1"},
+			wait:        100 * time.Millisecond,
+			maxBatch:    100,
+			wantBatches: []int{2},
+		},
+		{
+			name:        "missing keys",
+			keys:        []string{"todo_1", "todo_60"},
+			wait:        100 * time.Millisecond,
+			maxBatch:    100,
+			wantBatches: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newTestLoader(context.Background(), values, tt.wait, tt.maxBatch)
+
+			got, errs := loadConcurrently(l.dataLoader, context.Background(), tt.keys)
+
+			for i, key := range tt.keys {
+				if _, ok := values[key]; !ok {
+					assert.ErrorAs(t, errs[i], new(*NotFoundError))
+
+					continue
+				}
+
+				assert.NoError(t, errs[i])
+				assert.Equal(t, values[key], got[i])
+			}
+
+			assert.Equal(t, tt.wantBatches, l.batchSizes())
+
+			// the results are cached for the next loads
+			_, _ = loadConcurrently(l.dataLoader, context.Background(), tt.keys)
+			assert.Len(t, l.batchSizes(), len(tt.wantBatches))
+		})
+	}
+}
+
+func TestDataLoaderLoadAll(t *testing.T) {
+	l := newTestLoader(context.Background(), map[string]string{"todo_1": "first", "todo_2": "second"}, time.Hour, 100)
+
+	// the keys are fetched right away instead of waiting for the window
+	got, errs := l.LoadAll(context.Background(), []string{"todo_1", "todo_3", "todo_2"})
+
+	assert.Equal(t, []string{"first", "", "second"}, got)
+	assert.NoError(t, errs[0])
+	assert.ErrorAs(t, errs[1], new(*NotFoundError))
+	assert.NoError(t, errs[2])
+
+	assert.Equal(t, []int{3}, l.batchSizes())
+}
+
+func TestDataLoaderCanceledCaller(t *testing.T) {
+	l := newTestLoader(context.Background(), map[string]string{"todo_1": "first", "todo_2": "second"}, 50*time.Millisecond, 100)
+
+	// the first key starts the batch, its caller is canceled before the batch is fetched
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := l.Load(ctx, "todo_1")
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the batch is still fetched for the other callers
+	v, err := l.Load(context.Background(), "todo_2")
+	require.NoError(t, err)
+	assert.Equal(t, "second", v)
+
+	v, err = l.Load(context.Background(), "todo_1")
+	require.NoError(t, err)
+	assert.Equal(t, "first", v)
+
+	assert.Equal(t, []int{2}, l.batchSizes())
+}
+
+func TestDataLoaderCanceledOperation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	l := newTestLoader(ctx, map[string]string{"todo_1": "first"}, time.Millisecond, 100)
+
+	cancel()
+
+	// the batch is fetched with the context of the operation
+	_, err := l.Load(context.Background(), "todo_1")
+	require.ErrorIs(t, err, context.Canceled)
+
+	// errors are not cached
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	assert.Empty(t, l.cache)
+}
+
+func TestLoadersMissing(t *testing.T) {
+	f := newTestFixture(t)
+
+	// without loaders in the context the todo is loaded with the client of the context
+	ctx := ent.NewContext(testutils.UserContext(f.member.ID, f.org.ID), f.client)
+
+	res, err := loadersFromContext(ctx).Todo.Load(ctx, f.memberTodo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, f.memberTodo.ID, res.ID)
+}
+
+func TestGraphBatchesTodos(t *testing.T) {
+	f := newTestFixture(t)
+
+	// count the todo queries of the request
+	var (
+		mu      sync.Mutex
+		queries int
+	)
+
+	f.client.Todo.Intercept(intercept.TraverseTodo(func(context.Context, *ent.TodoQuery) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		queries++
+
+		return nil
+	}))
+
+	res := f.query(t, f.member, f.org, `query($a: ID!, $b: ID!) {
+		a: todo(id: $a) { id name }
+		b: todo(id: $b) { id name }
+		c: node(id: $a) { id }
+		d: nodes(ids: [$a, $b]) { id }
+	}`, map[string]any{"a": f.memberTodo.ID, "b": f.adminTodo.ID})
+
+	require.Empty(t, res.Errors)
+
+	assert.Equal(t, f.memberTodo.ID, res.Data["a"].(map[string]any)["id"])
+	assert.Equal(t, f.adminTodo.ID, res.Data["b"].(map[string]any)["id"])
+	assert.Equal(t, f.memberTodo.ID, res.Data["c"].(map[string]any)["id"])
+	assert.Len(t, res.Data["d"], 2)
+
+	// the todos of all fields are fetched in a single batch, or two when the nodes are fetched before the window passes
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.LessOrEqual(t, queries, 2)
+	assert.Positive(t, queries)
+}
@@ -6,22 +6,74 @@ package graphapi
 
 import (
 	"context"
-	"fmt"
 
+	"entgo.io/contrib/entgql"
 	"github.com/datumforge/go-template/internal/ent/generated"
 )
 
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id string) (generated.Noder, error) {
-	panic(fmt.Errorf("not implemented: Node - node"))
+	return loadNode(ctx, id)
 }
 
 // Nodes is the resolver for the nodes field.
 func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]generated.Noder, error) {
-	panic(fmt.Errorf("not implemented: Nodes - nodes"))
+	return loadNodes(ctx, ids), nil
+}
+
+// Organizations is the resolver for the organizations field.
+func (r *queryResolver) Organizations(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.OrganizationOrder, where *generated.OrganizationWhereInput) (*generated.OrganizationConnection, error) {
+	return withTransactionalMutation(ctx).Organization.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithOrganizationOrder(orderBy),
+		generated.WithOrganizationFilter(where.Filter))
+}
+
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.TagOrder, where *generated.TagWhereInput) (*generated.TagConnection, error) {
+	return withTransactionalMutation(ctx).Tag.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithTagOrder(orderBy),
+		generated.WithTagFilter(where.Filter))
+}
+
+// Todos is the resolver for the todos field.
+func (r *queryResolver) Todos(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.TodoOrder, where *generated.TodoWhereInput) (*generated.TodoConnection, error) {
+	return withTransactionalMutation(ctx).Todo.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithTodoOrder(orderBy),
+		generated.WithTodoFilter(where.Filter))
+}
+
+// TodoHistories is the resolver for the todoHistories field.
+func (r *queryResolver) TodoHistories(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.TodoHistoryOrder, where *generated.TodoHistoryWhereInput) (*generated.TodoHistoryConnection, error) {
+	return withTransactionalMutation(ctx).TodoHistory.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithTodoHistoryOrder(orderBy),
+		generated.WithTodoHistoryFilter(where.Filter))
 }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// TodoEdge returns TodoEdgeResolver implementation.
+func (r *Resolver) TodoEdge() TodoEdgeResolver { return &todoEdgeResolver{r} }
+
 type queryResolver struct{ *Resolver }
+type todoEdgeResolver struct{ *Resolver }
@@ -0,0 +1,220 @@
+package graphapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/ent/search"
+)
+
+// Error codes returned to clients in the extensions of a graphql error
+const (
+	// ErrCodeNotFound is returned when the requested object does not exist
+	ErrCodeNotFound = "NOT_FOUND"
+	// ErrCodeConflict is returned when the request conflicts with an existing object
+	ErrCodeConflict = "CONFLICT"
+	// ErrCodeBadUserInput is returned when the request input is invalid
+	ErrCodeBadUserInput = "BAD_USER_INPUT"
+	// ErrCodeForbidden is returned when the user is not allowed to perform the request
+	ErrCodeForbidden = "FORBIDDEN"
+	// ErrCodeInternal is returned for all unexpected errors
+	ErrCodeInternal = "INTERNAL"
+	// ErrCodeComplexityLimitExceeded is returned when an operation exceeds the configured max complexity
+	ErrCodeComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+	// ErrCodeDepthLimitExceeded is returned when an operation exceeds the configured max depth
+	ErrCodeDepthLimitExceeded = "DEPTH_LIMIT_EXCEEDED"
+	// ErrCodeRateLimited is returned when the cost of an operation exceeds the remaining rate limit budget
+	ErrCodeRateLimited = "RATE_LIMITED"
+	// ErrCodeOperationNotTrusted is returned when an operation is not in the trusted documents allow-list
+	ErrCodeOperationNotTrusted = "OPERATION_NOT_TRUSTED"
+	// ErrCodePersistedQueryNotFound is returned when the hash of a persisted query is unknown
+	ErrCodePersistedQueryNotFound = "PERSISTED_QUERY_NOT_FOUND"
+)
+
+var (
+	// ErrInternalServerError is returned when an internal error occurs.
+	ErrInternalServerError = errors.New("internal server error")
+
+	// ErrAlreadyExists is returned instead of the details of constraint errors, e.g. on duplicate names
+	ErrAlreadyExists = errors.New("object already exists")
+
+	// ErrPermissionDenied is returned when the user is not authorized to perform the requested query or mutation
+	ErrPermissionDenied = errors.New("you are not authorized to perform this action")
+
+	// ErrInvalidCSV is returned when the uploaded csv file can not be parsed
+	ErrInvalidCSV = errors.New("unable to parse csv file")
+
+	// ErrNoTrustedDocuments is returned when trusted documents are enabled but no documents were loaded
+	ErrNoTrustedDocuments = errors.New("no trusted documents found")
+
+	// ErrCostLimitUnavailable is returned when the cost limit is enabled but the budgets can not be stored in redis
+	ErrCostLimitUnavailable = errors.New("graphql cost limit requires a reachable redis")
+
+	// ErrInvalidCostLimit is returned when the cost limit is enabled without a budget or refill rate
+	ErrInvalidCostLimit = errors.New("graphql cost limit requires a positive budget and refill rate")
+)
+
+// PermissionDeniedError is returned when user is not authorized to perform the requested query or mutation
+type PermissionDeniedError struct {
+	Action     string
+	ObjectType string
+}
+
+// Error returns the PermissionDeniedError in string format
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("you are not authorized to perform this action: %s on %s", e.Action, e.ObjectType)
+}
+
+// newPermissionDeniedError returns a PermissionDeniedError
+func newPermissionDeniedError(a string, o string) *PermissionDeniedError {
+	return &PermissionDeniedError{
+		Action:     a,
+		ObjectType: o,
+	}
+}
+
+// NotFoundError is returned when the requested object does not exist
+type NotFoundError struct {
+	ObjectType string
+}
+
+// Error returns the NotFoundError in string format
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found", e.ObjectType)
+}
+
+// newNotFoundError returns a NotFoundError
+func newNotFoundError(o string) *NotFoundError {
+	return &NotFoundError{
+		ObjectType: o,
+	}
+}
+
+// AlreadyExistsError is returned when an object already exists
+type AlreadyExistsError struct {
+	ObjectType string
+}
+
+// Error returns the AlreadyExistsError in string format
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s already exists", e.ObjectType)
+}
+
+// newAlreadyExistsError returns a AlreadyExistsError
+func newAlreadyExistsError(o string) *AlreadyExistsError {
+	return &AlreadyExistsError{
+		ObjectType: o,
+	}
+}
+
+// BatchSizeExceededError is returned when a bulk request contains more objects than allowed
+type BatchSizeExceededError struct {
+	Size    int
+	MaxSize int
+}
+
+// Error returns the BatchSizeExceededError in string format
+func (e *BatchSizeExceededError) Error() string {
+	return fmt.Sprintf("batch of %d objects exceeds the maximum batch size of %d", e.Size, e.MaxSize)
+}
+
+// newBatchSizeExceededError returns a BatchSizeExceededError
+func newBatchSizeExceededError(size, maxSize int) *BatchSizeExceededError {
+	return &BatchSizeExceededError{
+		Size:    size,
+		MaxSize: maxSize,
+	}
+}
+
+// RowValidationError is returned for each invalid row of a bulk request
+type RowValidationError struct {
+	Row   int
+	Field string
+	Err   error
+}
+
+// Error returns the RowValidationError in string format
+func (e *RowValidationError) Error() string {
+	return fmt.Sprintf("row %d: invalid value for field %q: %v", e.Row, e.Field, e.Err)
+}
+
+// Unwrap returns the underlying validation error
+func (e *RowValidationError) Unwrap() error {
+	return e.Err
+}
+
+// BulkValidationError is returned when one or more rows of a bulk request are invalid,
+// the errors of the individual rows are added to the response
+type BulkValidationError struct {
+	Invalid int
+	Total   int
+}
+
+// Error returns the BulkValidationError in string format
+func (e *BulkValidationError) Error() string {
+	return fmt.Sprintf("%d of %d rows are invalid, no objects were created", e.Invalid, e.Total)
+}
+
+type action struct {
+	object string
+	action string
+}
+
+// parseRequestError logs and parses the error and returns the appropriate error type for the client
+func parseRequestError(err error, a action, logger *zap.SugaredLogger) error {
+	// log the error for debugging
+	logger.Debugw("error processing request", "action", a.action, "object", a.object, "error", err)
+
+	var notFoundErr *NotFoundError
+
+	switch {
+	case generated.IsValidationError(err):
+		validationError := err.(*generated.ValidationError)
+
+		logger.Debugw("validation error", "field", validationError.Name, "error", validationError.Error())
+
+		return validationError
+	case errors.Is(err, hooks.ErrInvalidStatusTransition), errors.Is(err, search.ErrEmptyQuery), errors.Is(err, ErrInvalidCursor):
+		logger.Debugw("invalid request", "error", err.Error())
+
+		return err
+	case generated.IsConstraintError(err):
+		constraintError := err.(*generated.ConstraintError)
+
+		logger.Debugw("constraint error", "error", constraintError.Error())
+
+		// Check for unique (or UNIQUE in sqlite) constraint error
+		if strings.Contains(strings.ToLower(constraintError.Error()), "unique") {
+			return newAlreadyExistsError(a.object)
+		}
+
+		return constraintError
+	case errors.Is(err, hooks.ErrVersionConflict):
+		logger.Debugw("version conflict", "error", err.Error())
+
+		return err
+	case errors.Is(err, hooks.ErrTagNotFound):
+		logger.Debugw("tag not found", "error", err.Error())
+
+		return newNotFoundError("tag")
+	case generated.IsNotFound(err), errors.As(err, &notFoundErr):
+		logger.Debugw("not found", "error", err.Error())
+
+		return newNotFoundError(a.object)
+	case errors.Is(err, privacy.Deny), errors.Is(err, interceptors.ErrMissingTenant), errors.Is(err, interceptors.ErrMissingUser):
+		logger.Debugw("permission denied", "error", err.Error())
+
+		return newPermissionDeniedError(a.action, a.object)
+	default:
+		logger.Errorw("unexpected error", "error", err.Error())
+
+		return err
+	}
+}
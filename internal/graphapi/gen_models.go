@@ -6,6 +6,39 @@ import (
 	"github.com/datumforge/go-template/internal/ent/generated"
 )
 
+type Subscription struct {
+}
+
+// Return response for createBulkTag mutation
+type TagBulkCreatePayload struct {
+	// Created tags
+	Tags []*generated.Tag `json:"tags,omitempty"`
+}
+
+// Return response for createTag mutation
+type TagCreatePayload struct {
+	// Created tag
+	Tag *generated.Tag `json:"tag"`
+}
+
+// Return response for deleteTag mutation
+type TagDeletePayload struct {
+	// Deleted tag ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateTag mutation
+type TagUpdatePayload struct {
+	// Updated tag
+	Tag *generated.Tag `json:"tag"`
+}
+
+// Return response for createBulkTodo mutation
+type TodoBulkCreatePayload struct {
+	// Created todos
+	Todos []*generated.Todo `json:"todos,omitempty"`
+}
+
 // Return response for createTodo mutation
 type TodoCreatePayload struct {
 	// Created todo
@@ -18,6 +51,20 @@ type TodoDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+// Return response for restoreTodo mutation
+type TodoRestorePayload struct {
+	// Restored todo
+	Todo *generated.Todo `json:"todo"`
+}
+
+// Fields of a todo with the matched terms highlighted
+type TodoSearchHighlight struct {
+	// Name of the todo
+	Name string `json:"name"`
+	// Snippet of the description of the todo
+	Description *string `json:"description,omitempty"`
+}
+
 // Return response for updateTodo mutation
 type TodoUpdatePayload struct {
 	// Updated todo
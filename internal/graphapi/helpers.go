@@ -2,15 +2,28 @@ package graphapi
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/gocarina/gocsv"
+	"go.uber.org/zap"
 
 	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/events"
 )
 
+// ErrSubscriptionsDisabled is returned when subscribing without an event broker configured
+var ErrSubscriptionsDisabled = errors.New("subscriptions are not enabled")
+
 // withTransactionalMutation automatically wrap the GraphQL mutations with a database transaction.
 // This allows the ent.Client to commit at the end, or rollback the transaction in case of a GraphQL error.
-func withTransactionalMutation(ctx context.Context) *ent.Client { //nolint:unused
+func withTransactionalMutation(ctx context.Context) *ent.Client {
 	return ent.FromContext(ctx)
 }
 
@@ -21,3 +34,157 @@ func injectClient(client *ent.Client) graphql.OperationMiddleware {
 		return next(ctx)
 	}
 }
+
+// withExpectedVersion adds the expected version of the mutated object to the context when it was
+// provided by the client, the mutation fails with a conflict when the object is at a different version
+func withExpectedVersion(ctx context.Context, version *int) context.Context {
+	if version == nil {
+		return ctx
+	}
+
+	return hooks.WithExpectedVersion(ctx, *version)
+}
+
+// csvRow is a row of a csv upload, converted to the create input of the object
+type csvRow[T any] interface {
+	input(row int) (*T, error)
+}
+
+// unmarshalBulkData unmarshals the rows of the csv upload and returns the create input of each row,
+// the rows only contain the scalar columns as the edges of the inputs can not be read from csv
+func unmarshalBulkData[R csvRow[T], T any](input graphql.Upload) ([]*T, error) {
+	// read the csv file
+	var rows []R
+
+	stream, err := io.ReadAll(input.File)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the csv
+	if err := gocsv.UnmarshalBytes(stream, &rows); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+
+	data := make([]*T, len(rows))
+
+	for i, row := range rows {
+		if data[i], err = row.input(i); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCSV, err)
+		}
+	}
+
+	return data, nil
+}
+
+// optionalColumn returns nil for an empty csv column so the field is not set
+func optionalColumn(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// subscribe returns a channel that receives the events published to the topic for the organization
+// of the subscriber, decoded into T; the channel is closed when the subscription ends
+func subscribe[T any](ctx context.Context, b *events.Broker, topic string, logger *zap.SugaredLogger) (<-chan T, error) {
+	if b == nil {
+		return nil, ErrSubscriptionsDisabled
+	}
+
+	// only receive the events of the organization of the subscriber
+	orgID, err := interceptors.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := b.Subscribe(ctx, hooks.TenantTopic(topic, orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan T)
+
+	go func() {
+		defer close(ch)
+
+		for payload := range msgs {
+			var v T
+
+			if err := json.Unmarshal(payload, &v); err != nil {
+				logger.Errorw("unable to decode event", "topic", topic, "error", err)
+
+				continue
+			}
+
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// subscribeTodos returns a channel that receives the todos of the events published to the topic, each todo
+// is loaded with the context of the subscriber so its privacy and tenant rules apply; the events of todos
+// the subscriber can not see are dropped
+func subscribeTodos(ctx context.Context, client *ent.Client, topic string, logger *zap.SugaredLogger) (<-chan *ent.Todo, error) {
+	events, err := subscribe[hooks.TodoEvent](ctx, client.Events, topic, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *ent.Todo)
+
+	go func() {
+		defer close(ch)
+
+		for e := range events {
+			t, err := client.Todo.Get(ctx, e.ID)
+			if err != nil {
+				if !ent.IsNotFound(err) && !errors.Is(err, privacy.Deny) {
+					logger.Errorw("unable to load the todo of the event", "topic", topic, "id", e.ID, "error", err)
+				}
+
+				continue
+			}
+
+			select {
+			case ch <- t:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// subscribeTodoIDs returns a channel that receives the ids of the todos of the events published to the topic,
+// e.g. of deleted todos that can not be loaded anymore
+func subscribeTodoIDs(ctx context.Context, client *ent.Client, topic string, logger *zap.SugaredLogger) (<-chan string, error) {
+	events, err := subscribe[hooks.TodoEvent](ctx, client.Events, topic, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan string)
+
+	go func() {
+		defer close(ch)
+
+		for e := range events {
+			select {
+			case ch <- e.ID:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,100 @@
+package graphapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/testutils"
+	"github.com/datumforge/go-template/pkg/fgamem"
+)
+
+func TestTodoHistories(t *testing.T) {
+	srv := fgamem.NewServer()
+	t.Cleanup(srv.Close)
+
+	fc, err := srv.NewClient(context.Background(), "../../fga/model/datum.fga", zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	f := newTestFixture(t, ent.Authz(*fc))
+	f.client.Todo.Use(hooks.HookTodoHistory())
+
+	// a todo of each organization, the todo of the organization is changed once
+	todo := f.client.Todo.Create().SetName("history").SaveX(testutils.UserContext(f.member.ID, f.org.ID))
+	f.client.Todo.UpdateOne(todo).SetDescription("changed").ExecX(testutils.UserContext(f.member.ID, f.org.ID))
+
+	otherTodo := f.client.Todo.Create().SetName("globex history").SaveX(testutils.UserContext(f.outsider.ID, f.otherOrg.ID))
+
+	const query = `query($where: TodoHistoryWhereInput) {
+		todoHistories(where: $where) { edges { node { ref operation } } }
+	}`
+
+	tests := []struct {
+		name     string
+		user     *ent.User
+		org      *ent.Organization
+		where    map[string]any
+		wantRefs []string
+		wantCode string
+	}{
+		{
+			name:     "member of the organization",
+			user:     f.member,
+			org:      f.org,
+			wantRefs: []string{todo.ID, todo.ID},
+		},
+		{
+			name:     "history of a todo",
+			user:     f.admin,
+			org:      f.org,
+			where:    map[string]any{"ref": todo.ID},
+			wantRefs: []string{todo.ID, todo.ID},
+		},
+		{
+			name:     "member of the other organization",
+			user:     f.outsider,
+			org:      f.otherOrg,
+			wantRefs: []string{otherTodo.ID},
+		},
+		{
+			name:     "history of a todo of the other organization",
+			user:     f.outsider,
+			org:      f.otherOrg,
+			where:    map[string]any{"ref": todo.ID},
+			wantRefs: []string{},
+		},
+		{
+			name:     "not a member of the organization",
+			user:     f.outsider,
+			org:      f.org,
+			wantCode: ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.query(t, tt.user, tt.org, query, map[string]any{"where": tt.where})
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			refs := []string{}
+
+			for _, e := range res.Data["todoHistories"].(map[string]any)["edges"].([]any) {
+				refs = append(refs, e.(map[string]any)["node"].(map[string]any)["ref"].(string))
+			}
+
+			assert.ElementsMatch(t, tt.wantRefs, refs)
+		})
+	}
+}
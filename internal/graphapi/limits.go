@@ -0,0 +1,203 @@
+package graphapi
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+)
+
+const (
+	// defaultConnectionCount is the number of items assumed for a connection field when
+	// neither first nor last is provided, and for the edges returning a list without pagination
+	defaultConnectionCount = 100
+
+	// complexityStatsExtension matches the gqlgen extension name so the stats can be
+	// read with extension.GetComplexityStats
+	complexityStatsExtension = "ComplexityLimit"
+)
+
+// rejectedOperations counts the graphql operations rejected because of the query limits
+var rejectedOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "graphql_rejected_operations_total",
+	Help: "The total number of graphql operations rejected for exceeding the complexity, depth or cost limits",
+}, []string{"reason"})
+
+// queryLimits is a graphql extension that rejects operations which exceed
+// the configured max complexity or max depth
+type queryLimits struct {
+	maxComplexity int
+	maxDepth      int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &queryLimits{}
+
+// ExtensionName returns the name of the extension
+func (q *queryLimits) ExtensionName() string {
+	return "QueryLimits"
+}
+
+// Validate stores the executable schema used to calculate the complexity
+func (q *queryLimits) Validate(schema graphql.ExecutableSchema) error {
+	q.es = schema
+
+	return nil
+}
+
+// MutateOperationContext calculates the depth and complexity of the operation and returns an error
+// when either exceeds the limit
+func (q *queryLimits) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	if q.maxDepth > 0 {
+		if depth := selectionSetDepth(op.SelectionSet); depth > q.maxDepth {
+			rejectedOperations.WithLabelValues("depth").Inc()
+
+			err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, q.maxDepth)
+			errcode.Set(err, ErrCodeDepthLimitExceeded)
+
+			return err
+		}
+	}
+
+	if q.maxComplexity > 0 {
+		c := complexity.Calculate(q.es, op, rc.Variables)
+
+		rc.Stats.SetExtension(complexityStatsExtension, &extension.ComplexityStats{
+			Complexity:      c,
+			ComplexityLimit: q.maxComplexity,
+		})
+
+		if c > q.maxComplexity {
+			rejectedOperations.WithLabelValues("complexity").Inc()
+
+			err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", c, q.maxComplexity)
+			errcode.Set(err, ErrCodeComplexityLimitExceeded)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectionSetDepth returns the max depth of the selection set, introspection fields are not counted
+func selectionSetDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+
+	for _, selection := range selectionSet {
+		var d int
+
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+
+			d = selectionSetDepth(s.SelectionSet) + 1
+		case *ast.InlineFragment:
+			d = selectionSetDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				d = selectionSetDepth(s.Definition.SelectionSet)
+			}
+		}
+
+		depth = max(depth, d)
+	}
+
+	return depth
+}
+
+// connectionComplexity returns the complexity of a connection field, scaled by the number of
+// items requested with first or last
+func connectionComplexity(childComplexity int, first, last *int) int {
+	count := defaultConnectionCount
+
+	switch {
+	case first != nil:
+		count = *first
+	case last != nil:
+		count = *last
+	}
+
+	return listComplexity(childComplexity, count)
+}
+
+// listComplexity returns the complexity of a field returning a list with the number of items
+func listComplexity(childComplexity, count int) int {
+	return 1 + childComplexity*max(count, 0)
+}
+
+// complexityRoot returns the complexity functions of the connection and list fields in the schema
+func complexityRoot() ComplexityRoot {
+	c := ComplexityRoot{}
+
+	c.Query.Organizations = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.OrganizationOrder, _ *generated.OrganizationWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Query.Nodes = func(childComplexity int, ids []string) int {
+		return listComplexity(childComplexity, len(ids))
+	}
+
+	c.Organization.Members = func(childComplexity int) int {
+		return listComplexity(childComplexity, defaultConnectionCount)
+	}
+
+	c.User.Memberships = func(childComplexity int) int {
+		return listComplexity(childComplexity, defaultConnectionCount)
+	}
+
+	c.Organization.Todos = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TodoOrder, _ *generated.TodoWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Organization.Tags = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TagOrder, _ *generated.TagWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Query.SearchTodos = func(childComplexity int, _ string, first *int, _ *entgql.Cursor[string]) int {
+		return connectionComplexity(childComplexity, first, nil)
+	}
+
+	c.Query.Tags = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TagOrder, _ *generated.TagWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Tag.Todos = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TodoOrder, _ *generated.TodoWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Todo.Tags = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TagOrder, _ *generated.TagWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Query.Todos = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TodoOrder, _ *generated.TodoWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Query.TodoHistories = func(childComplexity int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *generated.TodoHistoryOrder, _ *generated.TodoHistoryWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	return c
+}
@@ -0,0 +1,196 @@
+package graphapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/datumforge/go-template/config"
+)
+
+func TestSelectionSetDepth(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantDepth int
+	}{
+		{
+			name:      "single field",
+			query:     `query { todo(id: "1") { id } }`,
+			wantDepth: 2,
+		},
+		{
+			name:      "deepest selection",
+			query:     `query { todo(id: "1") { id } todos { edges { node { id tags { edges { node { name } } } } } } }`,
+			wantDepth: 7,
+		},
+		{
+			name:      "inline fragments are not counted",
+			query:     `query { node(id: "1") { ... on Todo { id name } } }`,
+			wantDepth: 2,
+		},
+		{
+			name:      "fragment spreads are not counted",
+			query:     `query { todos { edges { node { ...TodoFields } } } } fragment TodoFields on Todo { id }`,
+			wantDepth: 4,
+		},
+		{
+			name:      "introspection fields are not counted",
+			query:     `query { __schema { types { fields { name } } } todo(id: "1") { __typename } }`,
+			wantDepth: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.ParseQuery(&ast.Source{Input: tt.query})
+			require.NoError(t, err)
+
+			// the definition of the fragment spreads is set when the document is validated
+			for _, op := range doc.Operations {
+				setFragmentDefinitions(doc, op.SelectionSet)
+			}
+
+			assert.Equal(t, tt.wantDepth, selectionSetDepth(doc.Operations[0].SelectionSet))
+		})
+	}
+}
+
+// setFragmentDefinitions sets the definition of the fragment spreads of the selection set
+func setFragmentDefinitions(doc *ast.QueryDocument, selectionSet ast.SelectionSet) {
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			setFragmentDefinitions(doc, s.SelectionSet)
+		case *ast.InlineFragment:
+			setFragmentDefinitions(doc, s.SelectionSet)
+		case *ast.FragmentSpread:
+			s.Definition = doc.Fragments.ForName(s.Name)
+			if s.Definition != nil {
+				setFragmentDefinitions(doc, s.Definition.SelectionSet)
+			}
+		}
+	}
+}
+
+func TestConnectionComplexity(t *testing.T) {
+	ptr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name            string
+		childComplexity int
+		first           *int
+		last            *int
+		want            int
+	}{
+		{
+			name:            "first",
+			childComplexity: 3,
+			first:           ptr(10),
+			want:            31,
+		},
+		{
+			name:            "last",
+			childComplexity: 3,
+			last:            ptr(5),
+			want:            16,
+		},
+		{
+			name:            "first takes precedence over last",
+			childComplexity: 3,
+			first:           ptr(2),
+			last:            ptr(50),
+			want:            7,
+		},
+		{
+			name:            "without pagination",
+			childComplexity: 2,
+			want:            1 + 2*defaultConnectionCount,
+		},
+		{
+			name:            "negative count",
+			childComplexity: 2,
+			first:           ptr(-10),
+			want:            1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, connectionComplexity(tt.childComplexity, tt.first, tt.last))
+		})
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	f := newTestFixture(t)
+
+	tests := []struct {
+		name          string
+		maxComplexity int
+		maxDepth      int
+		query         string
+		variables     map[string]any
+		wantCode      string
+	}{
+		{
+			name:          "within the limits",
+			maxComplexity: 100,
+			maxDepth:      4,
+			query:         `query { todos(first: 10) { edges { node { id name } } } }`,
+		},
+		{
+			name:          "complexity scaled by first",
+			maxComplexity: 100,
+			maxDepth:      4,
+			query:         `query { todos(first: 50) { edges { node { id name } } } }`,
+			wantCode:      ErrCodeComplexityLimitExceeded,
+		},
+		{
+			name:          "complexity of the default connection size",
+			maxComplexity: 100,
+			query:         `query { todos { edges { node { id } } } }`,
+			wantCode:      ErrCodeComplexityLimitExceeded,
+		},
+		{
+			name:          "complexity scaled by the number of nodes",
+			maxComplexity: 5,
+			query:         `query($ids: [ID!]!) { nodes(ids: $ids) { id } }`,
+			variables:     map[string]any{"ids": []string{"1", "2", "3", "4", "5"}},
+			wantCode:      ErrCodeComplexityLimitExceeded,
+		},
+		{
+			name:     "depth",
+			maxDepth: 3,
+			query:    `query { todos(first: 1) { edges { node { id } } } }`,
+			wantCode: ErrCodeDepthLimitExceeded,
+		},
+		{
+			name:  "limits disabled",
+			query: `query { todos { edges { node { id tags { edges { node { todos { edges { node { id } } } } } } } } } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f.handler = NewResolver(f.client).WithSettings(config.GraphQL{
+				MaxComplexity: tt.maxComplexity,
+				MaxDepth:      tt.maxDepth,
+			}).Handler(false)
+
+			res := f.query(t, f.member, f.org, tt.query, tt.variables)
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+				assert.Nil(t, res.Data)
+
+				return
+			}
+
+			assert.Empty(t, res.Errors)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/ids"
+)
+
+// ErrInvalidNodeID is returned when a global ID does not contain a known type prefix
+var ErrInvalidNodeID = errors.New("invalid node id")
+
+// nodeTables maps the ID prefix of each schema to the table the object is stored in
+var nodeTables = map[string]string{
+	ids.OrganizationPrefix:  organization.Table,
+	ids.UserPrefix:          user.Table,
+	ids.OrgMembershipPrefix: orgmembership.Table,
+	ids.TagPrefix:           tag.Table,
+	ids.TodoPrefix:          todo.Table,
+	ids.TodoHistoryPrefix:   todohistory.Table,
+}
+
+// nodeType resolves the table of a node from the type prefix of its global ID, it is
+// used by the generated Noder(s) functions to route each ID to the correct table
+func nodeType(_ context.Context, id string) (string, error) {
+	prefix, ok := ids.Prefix(id)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrInvalidNodeID, id)
+	}
+
+	table, ok := nodeTables[prefix]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrInvalidNodeID, id)
+	}
+
+	if err := ids.Validate(prefix)(id); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidNodeID, err)
+	}
+
+	return table, nil
+}
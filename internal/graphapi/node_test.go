@@ -0,0 +1,168 @@
+package graphapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestNodeType(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		wantTable string
+		wantErr   bool
+	}{
+		{
+			name:      "todo",
+			id:        "todo_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantTable: todo.Table,
+		},
+		{
+			name:      "tag",
+			id:        "tag_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantTable: tag.Table,
+		},
+		{
+			name:    "unknown prefix",
+			id:      "invoice_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantErr: true,
+		},
+		{
+			name:    "without a prefix",
+			id:      "01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantErr: true,
+		},
+		{
+			name:    "invalid ulid",
+			id:      "todo_1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := nodeType(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidNodeID)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTable, table)
+		})
+	}
+}
+
+func TestNodeQuery(t *testing.T) {
+	f := newTestFixture(t)
+
+	tests := []struct {
+		name         string
+		id           string
+		wantTypename string
+		wantCode     string
+	}{
+		{
+			name:         "todo",
+			id:           f.memberTodo.ID,
+			wantTypename: "Todo",
+		},
+		{
+			name:         "organization",
+			id:           f.org.ID,
+			wantTypename: "Organization",
+		},
+		{
+			name:     "todo that does not exist",
+			id:       "todo_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantCode: ErrCodeNotFound,
+		},
+		{
+			name:     "unknown prefix",
+			id:       "invoice_01HZX3Q9Y8J1V5R2M6N7K4P0TS",
+			wantCode: ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.query(t, f.member, f.org, `query($id: ID!) { node(id: $id) { __typename id } }`, map[string]any{"id": tt.id})
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode(), res.Errors)
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			node := res.Data["node"].(map[string]any)
+			assert.Equal(t, tt.wantTypename, node["__typename"])
+			assert.Equal(t, tt.id, node["id"])
+		})
+	}
+}
+
+func TestNodesQuery(t *testing.T) {
+	f := newTestFixture(t)
+
+	urgent := f.client.Tag.Create().SetName("urgent").SaveX(testutils.UserContext(f.member.ID, f.org.ID))
+	otherTag := f.client.Tag.Create().SetName("urgent").SaveX(testutils.UserContext(f.outsider.ID, f.otherOrg.ID))
+
+	tests := []struct {
+		name          string
+		ids           []string
+		wantTypenames []string
+		wantErrors    int
+	}{
+		{
+			name:          "objects of different types in the order of the ids",
+			ids:           []string{urgent.ID, f.memberTodo.ID, f.org.ID, f.member.ID, f.adminTodo.ID},
+			wantTypenames: []string{"Tag", "Todo", "Organization", "User", "Todo"},
+		},
+		{
+			name:          "invalid and unknown ids",
+			ids:           []string{f.memberTodo.ID, "invoice_01HZX3Q9Y8J1V5R2M6N7K4P0TS", "todo_1", urgent.ID},
+			wantTypenames: []string{"Todo", "", "", "Tag"},
+			wantErrors:    2,
+		},
+		{
+			name:          "objects of another organization",
+			ids:           []string{otherTag.ID, f.otherOrg.ID, urgent.ID},
+			wantTypenames: []string{"", "", "Tag"},
+			wantErrors:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.query(t, f.member, f.org, `query($ids: [ID!]!) { nodes(ids: $ids) { __typename id } }`, map[string]any{"ids": tt.ids})
+
+			// each id that can not be resolved is reported with its own error, the other nodes are returned
+			require.Len(t, res.Errors, tt.wantErrors)
+
+			nodes := res.Data["nodes"].([]any)
+			require.Len(t, nodes, len(tt.ids))
+
+			for i, want := range tt.wantTypenames {
+				if want == "" {
+					assert.Nil(t, nodes[i])
+
+					continue
+				}
+
+				node := nodes[i].(map[string]any)
+				assert.Equal(t, want, node["__typename"])
+				assert.Equal(t, tt.ids[i], node["id"])
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	echo "github.com/datumforge/echox"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/privacy"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/ent/search"
+)
+
+const (
+	// codeExtension is the key of the error code in the graphql error extensions
+	codeExtension = "code"
+	// requestIDExtension is the key of the request id in the graphql error extensions
+	requestIDExtension = "requestID"
+)
+
+// errorPresenter adds a stable error code and the request id to the extensions of each error
+// returned to the client; details of internal and constraint errors are only returned when debug is enabled
+func (r *Resolver) errorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+
+	if requestID := requestIDFromContext(ctx); requestID != "" {
+		gqlErr.Extensions[requestIDExtension] = requestID
+	}
+
+	// keep codes that were already set, e.g. by entgql
+	if _, ok := gqlErr.Extensions[codeExtension]; ok {
+		return gqlErr
+	}
+
+	code := errorCode(ctx, err)
+	gqlErr.Extensions[codeExtension] = code
+
+	switch {
+	// do not leak internal errors (e.g. database errors) to the client
+	case code == ErrCodeInternal:
+		r.logger.Errorw("error processing graphql request", "error", err, "path", gqlErr.Path, "request_id", gqlErr.Extensions[requestIDExtension])
+
+		if !r.debug {
+			gqlErr.Message = ErrInternalServerError.Error()
+		}
+	// constraint errors are a conflict with an existing object, their message contains the names of tables and columns
+	case generated.IsConstraintError(err):
+		r.logger.Infow("constraint error processing graphql request", "error", err, "path", gqlErr.Path, "request_id", gqlErr.Extensions[requestIDExtension])
+
+		if !r.debug {
+			gqlErr.Message = ErrAlreadyExists.Error()
+		}
+	}
+
+	return gqlErr
+}
+
+// recoverFunc logs panics with the stack trace and returns an internal error to the client
+func (r *Resolver) recoverFunc(ctx context.Context, p interface{}) error {
+	r.logger.Errorw("panic recovered in graphql request", "panic", p, "request_id", requestIDFromContext(ctx), "stack", string(debug.Stack()))
+
+	if r.debug {
+		return fmt.Errorf("%w: %v", ErrInternalServerError, p)
+	}
+
+	return ErrInternalServerError
+}
+
+// errorCode returns the error code of the error to present to the client
+func errorCode(ctx context.Context, err error) string {
+	var (
+		notFoundErr         *NotFoundError
+		alreadyExistsErr    *AlreadyExistsError
+		permissionDeniedErr *PermissionDeniedError
+		batchSizeErr        *BatchSizeExceededError
+		rowValidationErr    *RowValidationError
+		bulkValidationErr   *BulkValidationError
+	)
+
+	switch {
+	case errors.As(err, &notFoundErr), generated.IsNotFound(err), errors.Is(err, ErrInvalidNodeID),
+		errors.Is(err, hooks.ErrTagNotFound):
+		return ErrCodeNotFound
+	case errors.As(err, &alreadyExistsErr), generated.IsConstraintError(err), errors.Is(err, hooks.ErrVersionConflict):
+		return ErrCodeConflict
+	case generated.IsValidationError(err), isArgumentError(ctx), errors.Is(err, ErrInvalidCSV),
+		errors.As(err, &batchSizeErr), errors.As(err, &rowValidationErr), errors.As(err, &bulkValidationErr),
+		errors.Is(err, hooks.ErrInvalidStatusTransition), errors.Is(err, search.ErrEmptyQuery), errors.Is(err, ErrInvalidCursor):
+		return ErrCodeBadUserInput
+	case errors.As(err, &permissionDeniedErr), errors.Is(err, ErrPermissionDenied), errors.Is(err, privacy.Deny),
+		errors.Is(err, interceptors.ErrMissingTenant), errors.Is(err, interceptors.ErrMissingUser):
+		return ErrCodeForbidden
+	default:
+		return ErrCodeInternal
+	}
+}
+
+// isArgumentError returns true when the error was raised while parsing the arguments of a field,
+// in that case the arguments were never set on the field context
+func isArgumentError(ctx context.Context) bool {
+	fc := graphql.GetFieldContext(ctx)
+
+	return fc != nil && fc.Args == nil && len(fc.Field.Arguments) > 0
+}
+
+// requestIDFromContext returns the request id set by the request id middleware, if available
+func requestIDFromContext(ctx context.Context) string {
+	ec, err := echocontext.EchoContextFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return ec.Response().Header().Get(echo.HeaderXRequestID)
+}
@@ -0,0 +1,83 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestErrorPresenter(t *testing.T) {
+	f := newTestFixture(t)
+	ctx := testutils.UserContext(f.member.ID, f.org.ID)
+
+	// names of tags are unique within an organization
+	f.client.Tag.Create().SetName("urgent").SaveX(ctx)
+
+	_, constraintErr := f.client.Tag.Create().SetName("urgent").Save(ctx)
+	require.True(t, generated.IsConstraintError(constraintErr), constraintErr)
+
+	tests := []struct {
+		name        string
+		err         error
+		debug       bool
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "internal error",
+			err:         errors.New("connection refused"),
+			wantCode:    ErrCodeInternal,
+			wantMessage: ErrInternalServerError.Error(),
+		},
+		{
+			name:        "internal error in debug mode",
+			err:         errors.New("connection refused"),
+			debug:       true,
+			wantCode:    ErrCodeInternal,
+			wantMessage: "connection refused",
+		},
+		{
+			name:        "constraint error",
+			err:         constraintErr,
+			wantCode:    ErrCodeConflict,
+			wantMessage: ErrAlreadyExists.Error(),
+		},
+		{
+			name:        "constraint error in debug mode",
+			err:         constraintErr,
+			debug:       true,
+			wantCode:    ErrCodeConflict,
+			wantMessage: constraintErr.Error(),
+		},
+		{
+			name:        "already exists",
+			err:         newAlreadyExistsError("tag"),
+			wantCode:    ErrCodeConflict,
+			wantMessage: "tag already exists",
+		},
+		{
+			name:        "not found",
+			err:         newNotFoundError("todo"),
+			wantCode:    ErrCodeNotFound,
+			wantMessage: "todo not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewResolver(f.client).WithLogger(zap.NewNop().Sugar()).WithDebug(tt.debug)
+
+			gqlErr := r.errorPresenter(context.Background(), tt.err)
+
+			assert.Equal(t, tt.wantCode, gqlErr.Extensions[codeExtension])
+			assert.Equal(t, tt.wantMessage, gqlErr.Message)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package graphapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/pkg/middleware/bearer"
+)
+
+func TestPublicOperations(t *testing.T) {
+	f := newTestFixture(t)
+
+	// the graph routes with the middleware of the server, the access tokens are never verified as none are sent
+	e := echo.New()
+	f.handler.Routes(e.Group("",
+		echocontext.EchoContextToContextMiddleware(),
+		bearer.Authenticate(bearer.Options{PublicOperations: []string{"__schema", "__type", "todos"}}),
+	))
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCode   string
+		wantData   string
+	}{
+		{
+			name:       "introspection",
+			query:      `{ __schema { queryType { name } } }`,
+			wantStatus: http.StatusOK,
+			wantData:   `{"__schema":{"queryType":{"name":"Query"}}}`,
+		},
+		{
+			name:       "public operation querying the database",
+			query:      `{ todos { edges { node { id name } } } }`,
+			wantStatus: http.StatusOK,
+			wantCode:   ErrCodeForbidden,
+		},
+		{
+			name:       "operation that is not public",
+			query:      `{ organizations { edges { node { id } } } }`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "public and private operations",
+			query:      `{ __schema { queryType { name } } organizations { edges { node { id } } } }`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(map[string]any{"query": tt.query})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, graphFullPath, bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var res struct {
+				graphResponse
+				Data json.RawMessage `json:"data"`
+			}
+
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+			assert.Equal(t, tt.wantCode, res.errorCode())
+
+			// the anonymous viewer never receives the todos of an organization
+			assert.NotContains(t, rec.Body.String(), f.memberTodo.ID)
+
+			if tt.wantData != "" {
+				assert.JSONEq(t, tt.wantData, string(res.Data))
+			}
+		})
+	}
+}
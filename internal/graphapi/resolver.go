@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -13,9 +14,11 @@ import (
 	echo "github.com/datumforge/echox"
 	"github.com/gorilla/websocket"
 	"github.com/ravilushqa/otelgqlgen"
+	"github.com/redis/go-redis/v9"
 	"github.com/wundergraph/graphql-go-tools/pkg/playground"
 	"go.uber.org/zap"
 
+	"github.com/datumforge/go-template/config"
 	ent "github.com/datumforge/go-template/internal/ent/generated"
 )
 
@@ -39,23 +42,49 @@ var (
 
 // Resolver provides a graph response resolver
 type Resolver struct {
-	client *ent.Client
-	logger *zap.SugaredLogger
+	client   *ent.Client
+	logger   *zap.SugaredLogger
+	debug    bool
+	settings config.GraphQL
+	redis    *redis.Client
 }
 
 // NewResolver returns a resolver configured with the given ent client
 func NewResolver(client *ent.Client) *Resolver {
 	return &Resolver{
 		client: client,
+		logger: zap.NewNop().Sugar(),
 	}
 }
 
+// WithLogger sets the logger used by the resolvers
 func (r Resolver) WithLogger(l *zap.SugaredLogger) *Resolver {
 	r.logger = l
 
 	return &r
 }
 
+// WithSettings sets the graphql handler settings, such as the query limits
+func (r Resolver) WithSettings(s config.GraphQL) *Resolver {
+	r.settings = s
+
+	return &r
+}
+
+// WithRedisClient sets the redis client used to share the persisted queries across server replicas
+func (r Resolver) WithRedisClient(rc *redis.Client) *Resolver {
+	r.redis = rc
+
+	return &r
+}
+
+// WithDebug returns the details of internal errors to the client when enabled
+func (r Resolver) WithDebug(d bool) *Resolver {
+	r.debug = d
+
+	return &r
+}
+
 // Handler is an http handler wrapping a Resolver
 type Handler struct {
 	r              *Resolver
@@ -66,10 +95,11 @@ type Handler struct {
 
 // Handler returns an http handler for a graph resolver
 func (r *Resolver) Handler(withPlayground bool) *Handler {
-	srv := handler.NewDefaultServer(
+	srv := handler.New(
 		NewExecutableSchema(
 			Config{
-				Resolvers: r,
+				Resolvers:  r,
+				Complexity: complexityRoot(),
 			},
 		),
 	)
@@ -89,13 +119,38 @@ func (r *Resolver) Handler(withPlayground bool) *Handler {
 
 	srv.SetQueryCache(lru.New(1000)) // nolint:mnd
 
+	srv.SetErrorPresenter(r.errorPresenter)
+	srv.SetRecoverFunc(r.recoverFunc)
+
 	srv.Use(extension.Introspection{})
-	srv.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New(100), // nolint:mnd
+	srv.Use(&queryLimits{
+		maxComplexity: r.settings.MaxComplexity,
+		maxDepth:      r.settings.MaxDepth,
 	})
+
+	// the settings and redis are checked with CheckCostLimit when the server starts
+	if r.settings.CostLimit.Enabled {
+		srv.Use(&costLimit{
+			client:     r.redis,
+			logger:     r.logger,
+			budget:     r.settings.CostLimit.Budget,
+			refillRate: r.settings.CostLimit.RefillRate,
+			failClosed: r.settings.CostLimit.FailClosed,
+		})
+	}
+	if r.settings.TrustedDocuments.Enabled {
+		srv.Use(r.trustedDocuments())
+	} else {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: r.persistedQueryCache(),
+		})
+	}
+
 	// add transactional db client
 	WithTransactions(srv, r.client)
 
+	srv.AroundOperations(r.includeDeleted())
+
 	srv.Use(otelgqlgen.Middleware())
 
 	h := &Handler{
@@ -114,11 +169,48 @@ func (r *Resolver) Handler(withPlayground bool) *Handler {
 	return h
 }
 
+// trustedDocuments loads the allow-list of operations from the manifest or the directory
+func (r *Resolver) trustedDocuments() *TrustedDocuments {
+	var (
+		td  *TrustedDocuments
+		err error
+	)
+
+	if r.settings.TrustedDocuments.Manifest != "" {
+		td, err = NewTrustedDocumentsFromManifest(r.settings.TrustedDocuments.Manifest)
+	} else {
+		td, err = NewTrustedDocumentsFromDir(r.settings.TrustedDocuments.Directory)
+	}
+
+	if err != nil {
+		r.logger.Fatalw("error loading trusted documents", "error", err)
+	}
+
+	r.logger.Infow("trusted documents mode enabled", "documents", len(td.Manifest()))
+
+	return td
+}
+
+// persistedQueryCache returns the cache used for automatic persisted queries, stored in redis
+// when enabled so the queries are shared across server replicas
+func (r *Resolver) persistedQueryCache() graphql.Cache {
+	if r.settings.PersistedQueries.UseRedis && r.redis != nil {
+		return newAPQCache(r.redis, r.logger, r.settings.PersistedQueries.TTL)
+	}
+
+	return lru.New(100) // nolint:mnd
+}
+
 // WithTransactions adds the transactioner to the ent db client
 func WithTransactions(h *handler.Server, c *ent.Client) {
 	// setup transactional db client
 	h.AroundOperations(injectClient(c))
 	h.Use(entgql.Transactioner{TxOpener: c})
+
+	// the dataloaders of the request use the transactional client, they are added by a response middleware
+	// instead of injectClient as the transaction of a mutation is opened by the transactioner after the operation
+	// middlewares ran; loaders created in injectClient would fetch with the client outside of the transaction
+	h.AroundResponses(injectLoaders)
 }
 
 // Handler returns the http.HandlerFunc for the GraphAPI
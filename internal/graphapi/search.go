@@ -0,0 +1,133 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"entgo.io/contrib/entgql"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/search"
+)
+
+// ErrInvalidCursor is returned when the cursor of a search does not hold a valid position
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// searchCursor is the value of the cursor of a search result; only the position of the result is encoded
+// in the cursor, the match is kept with the edge to resolve its rank and highlight
+type searchCursor struct {
+	position int
+	match    search.Result
+}
+
+// EncodeMsgpack encodes the position of the result as the value of the cursor
+func (c *searchCursor) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return enc.EncodeString(strconv.Itoa(c.position))
+}
+
+// searchMatch returns the match of the edge when it is the result of a search
+func searchMatch(edge *generated.TodoEdge) (search.Result, bool) {
+	c, ok := edge.Cursor.Value.(*searchCursor)
+	if !ok {
+		return search.Result{}, false
+	}
+
+	return c.match, true
+}
+
+// searchPosition returns the position of the search result the cursor points to, the search is
+// ordered by rank so the cursor holds the position of the result instead of a sort value
+func searchPosition(after *entgql.Cursor[string]) (int, error) {
+	if after == nil {
+		return 0, nil
+	}
+
+	value, ok := after.Value.(string)
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+
+	pos, err := strconv.Atoi(value)
+	if err != nil || pos < 0 {
+		return 0, ErrInvalidCursor
+	}
+
+	return pos, nil
+}
+
+// searchLimit returns the number of results of a page, capped to the max page size of the settings
+func (r *queryResolver) searchLimit(first *int) int {
+	limit := defaultConnectionCount
+	if first != nil {
+		limit = max(*first, 0)
+	}
+
+	if r.settings.MaxPageSize > 0 {
+		limit = min(limit, r.settings.MaxPageSize)
+	}
+
+	return limit
+}
+
+// searchTodos returns the page of todos matching the query after the cursor, ordered by relevance
+func (r *queryResolver) searchTodos(ctx context.Context, query string, first *int, after *entgql.Cursor[string]) (*generated.TodoConnection, error) {
+	limit := r.searchLimit(first)
+
+	offset, err := searchPosition(after)
+	if err != nil {
+		return nil, err
+	}
+
+	c := withTransactionalMutation(ctx)
+
+	// request one more result to know if there is a next page
+	results, err := search.Todos(ctx, c, query, limit+1, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := search.CountTodos(ctx, c, query)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &generated.TodoConnection{
+		PageInfo: entgql.PageInfo[string]{
+			HasNextPage:     len(results) > limit,
+			HasPreviousPage: offset > 0,
+		},
+		TotalCount: total,
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	ids := make([]string, len(results))
+	for i, res := range results {
+		ids[i] = res.ID
+	}
+
+	todos, errs := loadersFromContext(ctx).Todo.LoadAll(ctx, ids)
+
+	for i, res := range results {
+		// the todo may have been deleted since it was found
+		if errs[i] != nil {
+			continue
+		}
+
+		conn.Edges = append(conn.Edges, &generated.TodoEdge{
+			Node:   todos[i],
+			Cursor: entgql.Cursor[string]{ID: res.ID, Value: &searchCursor{position: offset + i + 1, match: res}},
+		})
+	}
+
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,50 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/datumforge/go-template/internal/ent/generated"
+)
+
+// SearchTodos is the resolver for the searchTodos field.
+func (r *queryResolver) SearchTodos(ctx context.Context, query string, first *int, after *entgql.Cursor[string]) (*generated.TodoConnection, error) {
+	res, err := r.searchTodos(ctx, query, first, after)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "todo"}, r.logger)
+	}
+
+	return res, nil
+}
+
+// Rank is the resolver for the rank field.
+func (r *todoEdgeResolver) Rank(ctx context.Context, obj *generated.TodoEdge) (*float64, error) {
+	match, ok := searchMatch(obj)
+	if !ok {
+		return nil, nil
+	}
+
+	return &match.Rank, nil
+}
+
+// Highlight is the resolver for the highlight field.
+func (r *todoEdgeResolver) Highlight(ctx context.Context, obj *generated.TodoEdge) (*TodoSearchHighlight, error) {
+	match, ok := searchMatch(obj)
+	if !ok {
+		return nil, nil
+	}
+
+	highlight := &TodoSearchHighlight{
+		Name: match.NameHighlight,
+	}
+
+	if match.DescriptionHighlight != "" {
+		highlight.Description = &match.DescriptionHighlight
+	}
+
+	return highlight, nil
+}
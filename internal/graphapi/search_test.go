@@ -0,0 +1,147 @@
+package graphapi
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/config"
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/search"
+)
+
+func TestSearchLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxPageSize int
+		first       *int
+		want        int
+	}{
+		{
+			name:        "default",
+			maxPageSize: 100,
+			want:        defaultConnectionCount,
+		},
+		{
+			name:        "first under the max page size",
+			maxPageSize: 100,
+			first:       intPtr(10),
+			want:        10,
+		},
+		{
+			name:        "first over the max page size",
+			maxPageSize: 100,
+			first:       intPtr(100000),
+			want:        100,
+		},
+		{
+			name:        "default over the max page size",
+			maxPageSize: 10,
+			want:        10,
+		},
+		{
+			name:        "negative first",
+			maxPageSize: 100,
+			first:       intPtr(-1),
+			want:        0,
+		},
+		{
+			name:  "no max page size",
+			first: intPtr(100000),
+			want:  100000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &queryResolver{Resolver: NewResolver(nil).WithSettings(config.GraphQL{MaxPageSize: tt.maxPageSize})}
+
+			assert.Equal(t, tt.want, r.searchLimit(tt.first))
+		})
+	}
+}
+
+func TestSearchCursor(t *testing.T) {
+	edge := &generated.TodoEdge{
+		Cursor: entgql.Cursor[string]{ID: "todo", Value: &searchCursor{position: 5, match: search.Result{ID: "todo", Rank: 1.5}}},
+	}
+
+	match, ok := searchMatch(edge)
+	require.True(t, ok)
+	assert.Equal(t, 1.5, match.Rank)
+
+	// only the position is sent to the client
+	var b bytes.Buffer
+
+	edge.Cursor.MarshalGQL(&b)
+
+	s, err := strconv.Unquote(b.String())
+	require.NoError(t, err)
+
+	var after entgql.Cursor[string]
+
+	require.NoError(t, after.UnmarshalGQL(s))
+
+	pos, err := searchPosition(&after)
+	require.NoError(t, err)
+	assert.Equal(t, 5, pos)
+
+	_, ok = searchMatch(&generated.TodoEdge{Cursor: after})
+	assert.False(t, ok)
+}
+
+func TestSearchPosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		after   *entgql.Cursor[string]
+		want    int
+		wantErr error
+	}{
+		{
+			name: "no cursor",
+			want: 0,
+		},
+		{
+			name:  "position",
+			after: &entgql.Cursor[string]{ID: "todo", Value: "10"},
+			want:  10,
+		},
+		{
+			name:    "not a position",
+			after:   &entgql.Cursor[string]{ID: "todo", Value: "todo"},
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "negative position",
+			after:   &entgql.Cursor[string]{ID: "todo", Value: "-1"},
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "sort value of another connection",
+			after:   &entgql.Cursor[string]{ID: "todo", Value: int64(10)},
+			wantErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, err := searchPosition(tt.after)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pos)
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
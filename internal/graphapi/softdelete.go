@@ -0,0 +1,51 @@
+package graphapi
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/datumforge/datum/pkg/auth"
+
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+)
+
+// headerIncludeDeleted is the request header used by admins to include soft deleted objects in the results
+const headerIncludeDeleted = "X-Include-Deleted"
+
+// includeDeleted includes the soft deleted objects in the results of the operation when requested
+// with the X-Include-Deleted header by an admin
+func (r *Resolver) includeDeleted() graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		oc := graphql.GetOperationContext(ctx)
+
+		include, _ := strconv.ParseBool(oc.Headers.Get(headerIncludeDeleted))
+		if !include {
+			return next(ctx)
+		}
+
+		if !r.isAdmin(ctx) {
+			err := gqlerror.Errorf("%s", newPermissionDeniedError(ActionGet, "deleted objects").Error())
+			errcode.Set(err, ErrCodeForbidden)
+
+			return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{err}})
+		}
+
+		return next(interceptors.WithDeleted(ctx))
+	}
+}
+
+// isAdmin returns true when the authenticated user is one of the configured admins
+func (r *Resolver) isAdmin(ctx context.Context) bool {
+	// the admins are configured with the subject of their tokens, which is not always a bare ulid
+	au, err := auth.GetAuthenticatedUserContext(ctx)
+	if err != nil || au.SubjectID == "" {
+		return false
+	}
+
+	return slices.Contains(r.settings.Admins, au.SubjectID)
+}
@@ -0,0 +1,32 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+)
+
+// TodoCreated is the resolver for the todoCreated field.
+func (r *subscriptionResolver) TodoCreated(ctx context.Context) (<-chan *generated.Todo, error) {
+	return subscribeTodos(ctx, r.client, hooks.TopicTodoCreated, r.logger)
+}
+
+// TodoUpdated is the resolver for the todoUpdated field.
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context) (<-chan *generated.Todo, error) {
+	return subscribeTodos(ctx, r.client, hooks.TopicTodoUpdated, r.logger)
+}
+
+// TodoDeleted is the resolver for the todoDeleted field.
+func (r *subscriptionResolver) TodoDeleted(ctx context.Context) (<-chan string, error) {
+	return subscribeTodoIDs(ctx, r.client, hooks.TopicTodoDeleted, r.logger)
+}
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,112 @@
+package graphapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/events"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+// eventTimeout is how long the tests wait for an event
+const eventTimeout = time.Second
+
+func TestSubscribeTodos(t *testing.T) {
+	broker := events.NewBroker()
+	t.Cleanup(func() { broker.Close() })
+
+	client := testutils.NewTestClient(t, ent.Events(broker))
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	otherOrg := client.Organization.Create().SetName("globex").SaveX(system)
+
+	member := client.User.Create().SetEmail("member@acme.com").SetDisplayName("member").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+
+	outsider := client.User.Create().SetEmail("member@globex.com").SetDisplayName("outsider").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(otherOrg.ID).SetUserID(outsider.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+
+	ctx, cancel := context.WithCancel(testutils.UserContext(member.ID, org.ID))
+	t.Cleanup(cancel)
+
+	todos, err := subscribeTodos(ctx, client, hooks.TopicTodoCreated, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	deleted, err := subscribeTodoIDs(ctx, client, hooks.TopicTodoDeleted, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	// the todo of another organization is published to the topic of that organization, it is not received
+	client.Todo.Create().SetName("globex todo").SaveX(testutils.UserContext(outsider.ID, otherOrg.ID))
+
+	created := client.Todo.Create().SetName("acme todo").SaveX(testutils.UserContext(member.ID, org.ID))
+
+	select {
+	case got := <-todos:
+		assert.Equal(t, created.ID, got.ID)
+
+		// the todo is loaded with the client, its edges can be resolved
+		owner, err := got.QueryOwner().Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, org.ID, owner.ID)
+	case <-time.After(eventTimeout):
+		require.Fail(t, "no todo received")
+	}
+
+	// the id of a deleted todo is received, no todo is received for it
+	client.Todo.DeleteOneID(created.ID).ExecX(testutils.UserContext(member.ID, org.ID))
+
+	select {
+	case id := <-deleted:
+		assert.Equal(t, created.ID, id)
+	case <-time.After(eventTimeout):
+		require.Fail(t, "no deleted todo received")
+	}
+
+	select {
+	case got := <-todos:
+		assert.Fail(t, "unexpected todo received", got.Name)
+	default:
+	}
+}
+
+func TestSubscribeTodosDropsDeletedTodos(t *testing.T) {
+	broker := events.NewBroker()
+	t.Cleanup(func() { broker.Close() })
+
+	client := testutils.NewTestClient(t, ent.Events(broker))
+	system := interceptors.SkipTenant(context.Background())
+
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	member := client.User.Create().SetEmail("member@acme.com").SetDisplayName("member").SaveX(system)
+	client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(member.ID).SetRole(orgmembership.RoleMEMBER).SaveX(system)
+
+	ctx, cancel := context.WithCancel(testutils.UserContext(member.ID, org.ID))
+	t.Cleanup(cancel)
+
+	todos, err := subscribeTodos(ctx, client, hooks.TopicTodoUpdated, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	// events of todos the subscriber can not load are dropped, the following events are still received
+	require.NoError(t, broker.Publish(ctx, hooks.TenantTopic(hooks.TopicTodoUpdated, org.ID), []byte(`{"id":"todo_unknown","op":"updated"}`)))
+
+	todo := client.Todo.Create().SetName("acme todo").SaveX(testutils.UserContext(member.ID, org.ID))
+	client.Todo.UpdateOneID(todo.ID).SetDescription("changed").ExecX(testutils.UserContext(member.ID, org.ID))
+
+	select {
+	case got := <-todos:
+		assert.Equal(t, todo.ID, got.ID)
+		assert.Equal(t, "changed", got.Description)
+	case <-time.After(eventTimeout):
+		require.Fail(t, "no todo received")
+	}
+}
@@ -0,0 +1,79 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/datumforge/go-template/internal/ent/generated"
+)
+
+// CreateTag is the resolver for the createTag field.
+func (r *mutationResolver) CreateTag(ctx context.Context, input generated.CreateTagInput) (*TagCreatePayload, error) {
+	res, err := withTransactionalMutation(ctx).Tag.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "tag"}, r.logger)
+	}
+
+	return &TagCreatePayload{
+		Tag: res,
+	}, nil
+}
+
+// CreateBulkTag is the resolver for the createBulkTag field.
+func (r *mutationResolver) CreateBulkTag(ctx context.Context, input []*generated.CreateTagInput) (*TagBulkCreatePayload, error) {
+	return r.bulkCreateTag(ctx, input)
+}
+
+// CreateBulkCSVTag is the resolver for the createBulkCSVTag field.
+func (r *mutationResolver) CreateBulkCSVTag(ctx context.Context, input graphql.Upload) (*TagBulkCreatePayload, error) {
+	data, err := unmarshalBulkData[tagCSVRow, generated.CreateTagInput](input)
+	if err != nil {
+		r.logger.Debugw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	return r.bulkCreateTag(ctx, data)
+}
+
+// UpdateTag is the resolver for the updateTag field.
+func (r *mutationResolver) UpdateTag(ctx context.Context, id string, input generated.UpdateTagInput) (*TagUpdatePayload, error) {
+	res, err := withTransactionalMutation(ctx).Tag.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "tag"}, r.logger)
+	}
+
+	return &TagUpdatePayload{
+		Tag: res,
+	}, nil
+}
+
+// DeleteTag is the resolver for the deleteTag field.
+func (r *mutationResolver) DeleteTag(ctx context.Context, id string) (*TagDeletePayload, error) {
+	if err := withTransactionalMutation(ctx).Tag.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "tag"}, r.logger)
+	}
+
+	return &TagDeletePayload{
+		DeletedID: id,
+	}, nil
+}
+
+// Tag is the resolver for the tag field.
+func (r *queryResolver) Tag(ctx context.Context, id string) (*generated.Tag, error) {
+	res, err := withTransactionalMutation(ctx).Tag.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "tag"}, r.logger)
+	}
+
+	return res, nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
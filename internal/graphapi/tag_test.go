@@ -0,0 +1,184 @@
+package graphapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/tag"
+	"github.com/datumforge/go-template/internal/testutils"
+)
+
+func TestValidateBulkTag(t *testing.T) {
+	color := func(s string) *string { return &s }
+
+	tests := []struct {
+		name       string
+		input      []*generated.CreateTagInput
+		wantFields map[int]string
+	}{
+		{
+			name: "valid",
+			input: []*generated.CreateTagInput{
+				{Name: "urgent", Color: color("#1f883d")},
+				{Name: "home"},
+			},
+			wantFields: map[int]string{},
+		},
+		{
+			name: "empty name",
+			input: []*generated.CreateTagInput{
+				{Name: "urgent"},
+				{Name: ""},
+			},
+			wantFields: map[int]string{1: tag.FieldName},
+		},
+		{
+			name: "invalid color",
+			input: []*generated.CreateTagInput{
+				{Name: "urgent", Color: color("green")},
+				{Name: "home", Color: color("#1f883")},
+			},
+			wantFields: map[int]string{0: tag.FieldColor, 1: tag.FieldColor},
+		},
+		{
+			name: "duplicate names",
+			input: []*generated.CreateTagInput{
+				{Name: "urgent"},
+				{Name: "home"},
+				{Name: "urgent"},
+			},
+			wantFields: map[int]string{2: tag.FieldName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rowErrs := validateBulkTag(tt.input)
+
+			require.Len(t, rowErrs, len(tt.wantFields))
+
+			for row, field := range tt.wantFields {
+				var rowErr *RowValidationError
+
+				require.ErrorAs(t, rowErrs[row], &rowErr)
+				assert.Equal(t, row, rowErr.Row)
+				assert.Equal(t, field, rowErr.Field)
+			}
+		})
+	}
+}
+
+func TestCreateBulkTag(t *testing.T) {
+	f := newTestFixture(t)
+
+	res := f.query(t, f.member, f.org, `mutation($input: [CreateTagInput!]) {
+		createBulkTag(input: $input) { tags { id name } }
+	}`, map[string]any{"input": []map[string]any{
+		{"name": "urgent"},
+		{"name": "home", "color": "green"},
+		{"name": "urgent"},
+	}})
+
+	// each invalid row is reported with its index, no tags are created
+	require.Len(t, res.Errors, 3)
+	assert.Nil(t, res.Data)
+	assert.Zero(t, f.client.Tag.Query().CountX(testutils.UserContext(f.member.ID, f.org.ID)))
+
+	res = f.query(t, f.member, f.org, `mutation($input: [CreateTagInput!]) {
+		createBulkTag(input: $input) { tags { id name owner { id } } }
+	}`, map[string]any{"input": []map[string]any{
+		{"name": "urgent", "color": "#1f883d"},
+		{"name": "home"},
+	}})
+
+	require.Empty(t, res.Errors)
+
+	tags := res.Data["createBulkTag"].(map[string]any)["tags"].([]any)
+	require.Len(t, tags, 2)
+
+	for _, tg := range tags {
+		assert.Equal(t, f.org.ID, tg.(map[string]any)["owner"].(map[string]any)["id"])
+	}
+}
+
+func TestTodoTags(t *testing.T) {
+	f := newTestFixture(t)
+
+	ctx := testutils.UserContext(f.member.ID, f.org.ID)
+
+	urgent := f.client.Tag.Create().SetName("urgent").SaveX(ctx)
+	home := f.client.Tag.Create().SetName("home").SaveX(ctx)
+	otherTag := f.client.Tag.Create().SetName("urgent").SaveX(testutils.UserContext(f.outsider.ID, f.otherOrg.ID))
+
+	const addTags = `mutation($id: ID!, $tagIDs: [ID!]!) {
+		addTodoTags(id: $id, tagIDs: $tagIDs) { todo { tags { edges { node { id } } } } }
+	}`
+
+	const removeTags = `mutation($id: ID!, $tagIDs: [ID!]!) {
+		removeTodoTags(id: $id, tagIDs: $tagIDs) { todo { tags { edges { node { id } } } } }
+	}`
+
+	tests := []struct {
+		name     string
+		query    string
+		tagIDs   []string
+		wantCode string
+		wantTags []string
+	}{
+		{
+			name:     "add tags",
+			query:    addTags,
+			tagIDs:   []string{urgent.ID, home.ID, urgent.ID},
+			wantTags: []string{urgent.ID, home.ID},
+		},
+		{
+			name:     "add a tag of another organization",
+			query:    addTags,
+			tagIDs:   []string{otherTag.ID},
+			wantCode: ErrCodeNotFound,
+		},
+		{
+			name:     "remove a tag",
+			query:    removeTags,
+			tagIDs:   []string{urgent.ID},
+			wantTags: []string{home.ID},
+		},
+		{
+			name:     "remove a tag that was not added",
+			query:    removeTags,
+			tagIDs:   []string{urgent.ID},
+			wantTags: []string{home.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.query(t, f.member, f.org, tt.query, map[string]any{"id": f.memberTodo.ID, "tagIDs": tt.tagIDs})
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			var payload map[string]any
+			for _, v := range res.Data {
+				payload = v.(map[string]any)
+			}
+
+			edges := payload["todo"].(map[string]any)["tags"].(map[string]any)["edges"].([]any)
+
+			got := []string{}
+			for _, e := range edges {
+				got = append(got, e.(map[string]any)["node"].(map[string]any)["id"].(string))
+			}
+
+			assert.ElementsMatch(t, tt.wantTags, got)
+		})
+	}
+}
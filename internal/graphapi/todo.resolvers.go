@@ -6,32 +6,137 @@ package graphapi
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
 )
 
 // CreateTodo is the resolver for the createTodo field.
 func (r *mutationResolver) CreateTodo(ctx context.Context, input generated.CreateTodoInput) (*TodoCreatePayload, error) {
-	panic(fmt.Errorf("not implemented: CreateTodo - createTodo"))
+	res, err := withTransactionalMutation(ctx).Todo.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "todo"}, r.logger)
+	}
+
+	return &TodoCreatePayload{
+		Todo: res,
+	}, nil
+}
+
+// CreateBulkTodo is the resolver for the createBulkTodo field.
+func (r *mutationResolver) CreateBulkTodo(ctx context.Context, input []*generated.CreateTodoInput) (*TodoBulkCreatePayload, error) {
+	return r.bulkCreateTodo(ctx, input)
+}
+
+// CreateBulkCSVTodo is the resolver for the createBulkCSVTodo field.
+func (r *mutationResolver) CreateBulkCSVTodo(ctx context.Context, input graphql.Upload) (*TodoBulkCreatePayload, error) {
+	data, err := unmarshalBulkData[todoCSVRow, generated.CreateTodoInput](input)
+	if err != nil {
+		r.logger.Debugw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	return r.bulkCreateTodo(ctx, data)
 }
 
 // UpdateTodo is the resolver for the updateTodo field.
-func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input generated.UpdateTodoInput) (*TodoUpdatePayload, error) {
-	panic(fmt.Errorf("not implemented: UpdateTodo - updateTodo"))
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input generated.UpdateTodoInput, expectedVersion *int) (*TodoUpdatePayload, error) {
+	ctx = withExpectedVersion(ctx, expectedVersion)
+
+	// the todo is read in the transaction instead of with the dataloader, so the version is checked
+	// against the current row and not a copy loaded earlier in the request
+	res, err := withTransactionalMutation(ctx).Todo.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "todo"}, r.logger)
+	}
+
+	res, err = res.Update().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "todo"}, r.logger)
+	}
+
+	loadersFromContext(ctx).Todo.Prime(id, res)
+
+	return &TodoUpdatePayload{
+		Todo: res,
+	}, nil
 }
 
 // DeleteTodo is the resolver for the deleteTodo field.
-func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (*TodoDeletePayload, error) {
-	panic(fmt.Errorf("not implemented: DeleteTodo - deleteTodo"))
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id string, expectedVersion *int) (*TodoDeletePayload, error) {
+	ctx = withExpectedVersion(ctx, expectedVersion)
+
+	if err := withTransactionalMutation(ctx).Todo.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "todo"}, r.logger)
+	}
+
+	loadersFromContext(ctx).Todo.Clear(id)
+
+	return &TodoDeletePayload{
+		DeletedID: id,
+	}, nil
 }
 
-// Todo is the resolver for the todo field.
-func (r *queryResolver) Todo(ctx context.Context, id string) (*generated.Todo, error) {
-	panic(fmt.Errorf("not implemented: Todo - todo"))
+// RestoreTodo is the resolver for the restoreTodo field.
+func (r *mutationResolver) RestoreTodo(ctx context.Context, id string) (*TodoRestorePayload, error) {
+	// deleted todos can only be seen, and so restored, by the admins
+	if !r.isAdmin(ctx) {
+		return nil, newPermissionDeniedError(ActionUpdate, "deleted todo")
+	}
+
+	res, err := withTransactionalMutation(ctx).Todo.UpdateOneID(id).
+		Where(todo.DeletedAtNotNil()).
+		ClearDeletedAt().
+		ClearDeletedBy().
+		Save(interceptors.WithDeleted(ctx))
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "todo"}, r.logger)
+	}
+
+	loadersFromContext(ctx).Todo.Prime(id, res)
+
+	return &TodoRestorePayload{
+		Todo: res,
+	}, nil
+}
+
+// AddTodoTags is the resolver for the addTodoTags field.
+func (r *mutationResolver) AddTodoTags(ctx context.Context, id string, tagIDs []string) (*TodoUpdatePayload, error) {
+	res, err := withTransactionalMutation(ctx).Todo.UpdateOneID(id).AddTagIDs(tagIDs...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "todo"}, r.logger)
+	}
+
+	loadersFromContext(ctx).Todo.Prime(id, res)
+
+	return &TodoUpdatePayload{
+		Todo: res,
+	}, nil
 }
 
-// Mutation returns MutationResolver implementation.
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+// RemoveTodoTags is the resolver for the removeTodoTags field.
+func (r *mutationResolver) RemoveTodoTags(ctx context.Context, id string, tagIDs []string) (*TodoUpdatePayload, error) {
+	res, err := withTransactionalMutation(ctx).Todo.UpdateOneID(id).RemoveTagIDs(tagIDs...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "todo"}, r.logger)
+	}
 
-type mutationResolver struct{ *Resolver }
+	loadersFromContext(ctx).Todo.Prime(id, res)
+
+	return &TodoUpdatePayload{
+		Todo: res,
+	}, nil
+}
+
+// Todo is the resolver for the todo field.
+func (r *queryResolver) Todo(ctx context.Context, id string) (*generated.Todo, error) {
+	res, err := loadersFromContext(ctx).Todo.Load(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "todo"}, r.logger)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,600 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datumforge/datum/pkg/auth"
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/config"
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/testutils"
+	"github.com/datumforge/go-template/pkg/fgamem"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+// testFixture holds two organizations with their members and the todos of the first organization
+type testFixture struct {
+	client  *ent.Client
+	handler *Handler
+
+	org      *ent.Organization
+	otherOrg *ent.Organization
+
+	owner    *ent.User
+	admin    *ent.User
+	member   *ent.User
+	outsider *ent.User
+
+	memberTodo *ent.Todo
+	adminTodo  *ent.Todo
+}
+
+func newTestFixture(t *testing.T, opts ...ent.Option) *testFixture {
+	t.Helper()
+
+	client := testutils.NewTestClient(t, opts...)
+	ctx := interceptors.SkipTenant(context.Background())
+
+	f := &testFixture{
+		client:  client,
+		handler: NewResolver(client).Handler(false),
+	}
+
+	f.org = client.Organization.Create().SetName("acme").SaveX(ctx)
+	f.otherOrg = client.Organization.Create().SetName("globex").SaveX(ctx)
+
+	newMember := func(email string, org *ent.Organization, role orgmembership.Role) *ent.User {
+		u := client.User.Create().SetEmail(email).SetDisplayName(email).SaveX(ctx)
+		client.OrgMembership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(role).SaveX(ctx)
+
+		return u
+	}
+
+	f.owner = newMember("owner@acme.com", f.org, orgmembership.RoleOWNER)
+	f.admin = newMember("admin@acme.com", f.org, orgmembership.RoleADMIN)
+	f.member = newMember("member@acme.com", f.org, orgmembership.RoleMEMBER)
+	f.outsider = newMember("member@globex.com", f.otherOrg, orgmembership.RoleOWNER)
+
+	f.memberTodo = client.Todo.Create().SetName("member todo").SaveX(testutils.UserContext(f.member.ID, f.org.ID))
+	f.adminTodo = client.Todo.Create().SetName("admin todo").SaveX(testutils.UserContext(f.admin.ID, f.org.ID))
+
+	return f
+}
+
+// graphResponse is the body of a graphql response
+type graphResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// errorCode returns the code of the first error of the response
+func (r graphResponse) errorCode() string {
+	if len(r.Errors) == 0 {
+		return ""
+	}
+
+	code, _ := r.Errors[0].Extensions[codeExtension].(string)
+
+	return code
+}
+
+// query sends the operation to the graph handler, authenticated as the user in the organization when set
+func (f *testFixture) query(t *testing.T, user *ent.User, org *ent.Organization, query string, variables map[string]any) graphResponse {
+	t.Helper()
+
+	rec := f.send(t, user, org, query, variables)
+
+	var res graphResponse
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	return res
+}
+
+// send sends the operation to the graph handler the same as query and returns the recorded response
+func (f *testFixture) send(t *testing.T, user *ent.User, org *ent.Organization, query string, variables map[string]any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return f.sendParams(t, user, org, map[string]any{"query": query, "variables": variables})
+}
+
+// sendParams sends the request parameters to the graph handler, e.g. with the extensions of a persisted query
+func (f *testFixture) sendParams(t *testing.T, user *ent.User, org *ent.Organization, params map[string]any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := json.Marshal(params)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, graphFullPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	if user != nil {
+		auth.AddAuthenticatedUserContext(c, &auth.AuthenticatedUser{
+			SubjectID:          user.ID,
+			OrganizationID:     org.ID,
+			OrganizationIDs:    []string{org.ID},
+			AuthenticationType: auth.JWTAuthentication,
+		})
+	}
+
+	f.handler.Handler()(rec, c.Request())
+
+	return rec
+}
+
+func TestTodoQueryPrivacy(t *testing.T) {
+	f := newTestFixture(t)
+
+	tests := []struct {
+		name      string
+		user      *ent.User
+		org       *ent.Organization
+		wantTodos int
+		wantCode  string
+	}{
+		{
+			name:      "owner",
+			user:      f.owner,
+			org:       f.org,
+			wantTodos: 2,
+		},
+		{
+			name:      "member",
+			user:      f.member,
+			org:       f.org,
+			wantTodos: 2,
+		},
+		{
+			name:      "member of another organization",
+			user:      f.outsider,
+			org:       f.otherOrg,
+			wantTodos: 0,
+		},
+		{
+			name:      "not a member of the organization in the context",
+			user:      f.outsider,
+			org:       f.org,
+			wantTodos: 0,
+		},
+		{
+			name:     "anonymous",
+			wantCode: ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.query(t, tt.user, tt.org, `query { todos { totalCount } }`, nil)
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			todos := res.Data["todos"].(map[string]any)
+			assert.EqualValues(t, tt.wantTodos, todos["totalCount"])
+		})
+	}
+}
+
+func TestTodoMutationPrivacy(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     func(f *testFixture) (*ent.User, *ent.Organization)
+		query    string
+		todo     func(f *testFixture) *ent.Todo
+		wantCode string
+	}{
+		{
+			name:  "member creates a todo",
+			user:  func(f *testFixture) (*ent.User, *ent.Organization) { return f.member, f.org },
+			query: `mutation { createTodo(input: {name: "new todo"}) { todo { id } } }`,
+		},
+		{
+			name:     "not a member creates a todo in the organization",
+			user:     func(f *testFixture) (*ent.User, *ent.Organization) { return f.outsider, f.org },
+			query:    `mutation { createTodo(input: {name: "new todo"}) { todo { id } } }`,
+			wantCode: ErrCodeForbidden,
+		},
+		{
+			name:     "anonymous creates a todo",
+			user:     func(*testFixture) (*ent.User, *ent.Organization) { return nil, nil },
+			query:    `mutation { createTodo(input: {name: "new todo"}) { todo { id } } }`,
+			wantCode: ErrCodeForbidden,
+		},
+		{
+			name:  "member updates their todo",
+			user:  func(f *testFixture) (*ent.User, *ent.Organization) { return f.member, f.org },
+			query: `mutation($id: ID!) { updateTodo(id: $id, input: {description: "changed"}) { todo { id } } }`,
+			todo:  func(f *testFixture) *ent.Todo { return f.memberTodo },
+		},
+		{
+			name:     "member updates the todo of another user",
+			user:     func(f *testFixture) (*ent.User, *ent.Organization) { return f.member, f.org },
+			query:    `mutation($id: ID!) { updateTodo(id: $id, input: {description: "changed"}) { todo { id } } }`,
+			todo:     func(f *testFixture) *ent.Todo { return f.adminTodo },
+			wantCode: ErrCodeNotFound,
+		},
+		{
+			name:  "admin updates the todo of another user",
+			user:  func(f *testFixture) (*ent.User, *ent.Organization) { return f.admin, f.org },
+			query: `mutation($id: ID!) { updateTodo(id: $id, input: {description: "changed"}) { todo { id } } }`,
+			todo:  func(f *testFixture) *ent.Todo { return f.memberTodo },
+		},
+		{
+			name:     "not a member updates a todo of the organization",
+			user:     func(f *testFixture) (*ent.User, *ent.Organization) { return f.outsider, f.org },
+			query:    `mutation($id: ID!) { updateTodo(id: $id, input: {description: "changed"}) { todo { id } } }`,
+			todo:     func(f *testFixture) *ent.Todo { return f.memberTodo },
+			wantCode: ErrCodeNotFound,
+		},
+		{
+			name:  "owner deletes the todo of another user",
+			user:  func(f *testFixture) (*ent.User, *ent.Organization) { return f.owner, f.org },
+			query: `mutation($id: ID!) { deleteTodo(id: $id) { deletedID } }`,
+			todo:  func(f *testFixture) *ent.Todo { return f.memberTodo },
+		},
+		{
+			name:     "member deletes the todo of another user",
+			user:     func(f *testFixture) (*ent.User, *ent.Organization) { return f.member, f.org },
+			query:    `mutation($id: ID!) { deleteTodo(id: $id) { deletedID } }`,
+			todo:     func(f *testFixture) *ent.Todo { return f.adminTodo },
+			wantCode: ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestFixture(t)
+			user, org := tt.user(f)
+
+			var variables map[string]any
+			if tt.todo != nil {
+				variables = map[string]any{"id": tt.todo(f).ID}
+			}
+
+			res := f.query(t, user, org, tt.query, variables)
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+
+				return
+			}
+
+			assert.Empty(t, res.Errors)
+		})
+	}
+}
+
+func TestTodoRelationQuery(t *testing.T) {
+	srv := fgamem.NewServer()
+	t.Cleanup(srv.Close)
+
+	fc, err := srv.NewClient(context.Background(), "../../fga/model/datum.fga", zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	f := newTestFixture(t, ent.Authz(*fc))
+
+	// a todo of the organization without its relationship tuples, no member can view it
+	hidden := f.client.Todo.Create().SetName("hidden").SaveX(testutils.UserContext(f.admin.ID, f.org.ID))
+	require.NoError(t, fc.DeleteAllObjectRelations(context.Background(), "todo:"+hidden.ID))
+
+	t.Run("todos fetched in a single batch", func(t *testing.T) {
+		res := f.query(t, f.member, f.org, `query($a: ID!, $b: ID!, $c: ID!) {
+			a: node(id: $a) { id }
+			b: node(id: $b) { id }
+			c: node(id: $c) { id }
+		}`, map[string]any{"a": hidden.ID, "b": f.memberTodo.ID, "c": f.adminTodo.ID})
+
+		// only the todo without the relation is not found, whichever field starts the batch
+		require.Len(t, res.Errors, 1)
+		assert.Equal(t, ErrCodeNotFound, res.errorCode())
+
+		assert.Nil(t, res.Data["a"])
+		assert.Equal(t, f.memberTodo.ID, res.Data["b"].(map[string]any)["id"])
+		assert.Equal(t, f.adminTodo.ID, res.Data["c"].(map[string]any)["id"])
+
+		res = f.query(t, f.member, f.org, `query($a: ID!, $b: ID!) {
+			a: todo(id: $a) { id }
+			b: todo(id: $b) { id }
+		}`, map[string]any{"a": f.memberTodo.ID, "b": hidden.ID})
+
+		assert.Equal(t, ErrCodeNotFound, res.errorCode())
+		assert.NotContains(t, fmt.Sprint(res.Data), hidden.ID)
+	})
+
+	t.Run("nodes", func(t *testing.T) {
+		res := f.query(t, f.member, f.org, `query($ids: [ID!]!) { nodes(ids: $ids) { id } }`,
+			map[string]any{"ids": []string{f.memberTodo.ID, hidden.ID, f.adminTodo.ID}})
+
+		require.Len(t, res.Errors, 1)
+		assert.Equal(t, ErrCodeNotFound, res.errorCode())
+
+		nodes := res.Data["nodes"].([]any)
+		require.Len(t, nodes, 3)
+
+		assert.Equal(t, f.memberTodo.ID, nodes[0].(map[string]any)["id"])
+		assert.Nil(t, nodes[1])
+		assert.Equal(t, f.adminTodo.ID, nodes[2].(map[string]any)["id"])
+	})
+
+	t.Run("todos connection", func(t *testing.T) {
+		res := f.query(t, f.member, f.org, `query { todos { edges { node { id } } } }`, nil)
+		require.Empty(t, res.Errors)
+
+		assert.NotContains(t, fmt.Sprint(res.Data), hidden.ID)
+		assert.Len(t, res.Data["todos"].(map[string]any)["edges"], 2)
+	})
+
+	t.Run("todos pages and counts", func(t *testing.T) {
+		query := `query($after: Cursor) {
+			todos(first: 1, after: $after) { totalCount pageInfo { hasNextPage endCursor } edges { node { id } } }
+		}`
+
+		var (
+			ids   []string
+			after any
+		)
+
+		for {
+			res := f.query(t, f.member, f.org, query, map[string]any{"after": after})
+			require.Empty(t, res.Errors)
+
+			conn := res.Data["todos"].(map[string]any)
+			assert.EqualValues(t, 2, conn["totalCount"])
+
+			// every page is full, the hidden todo is not counted as a row of a page
+			edges := conn["edges"].([]any)
+			require.Len(t, edges, 1)
+
+			ids = append(ids, edges[0].(map[string]any)["node"].(map[string]any)["id"].(string))
+
+			info := conn["pageInfo"].(map[string]any)
+			if !info["hasNextPage"].(bool) {
+				break
+			}
+
+			after = info["endCursor"]
+		}
+
+		assert.ElementsMatch(t, []string{f.memberTodo.ID, f.adminTodo.ID}, ids)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		res := f.query(t, f.member, f.org, `query { searchTodos(query: "todo hidden") { totalCount edges { node { id } } } }`, nil)
+		require.Empty(t, res.Errors)
+
+		assert.NotContains(t, fmt.Sprint(res.Data), hidden.ID)
+		assert.EqualValues(t, 0, res.Data["searchTodos"].(map[string]any)["totalCount"])
+
+		res = f.query(t, f.admin, f.org, `query { searchTodos(query: "todo") { totalCount edges { node { id } } } }`, nil)
+		require.Empty(t, res.Errors)
+
+		assert.EqualValues(t, 2, res.Data["searchTodos"].(map[string]any)["totalCount"])
+	})
+
+	t.Run("admin views the todo of the organization", func(t *testing.T) {
+		res := f.query(t, f.admin, f.org, `query($id: ID!) { todo(id: $id) { id } }`, map[string]any{"id": f.memberTodo.ID})
+		require.Empty(t, res.Errors)
+	})
+}
+
+func TestRestoreTodo(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     func(f *testFixture) *ent.User
+		deleted  bool
+		wantCode string
+	}{
+		{
+			name:    "admin restores a deleted todo",
+			user:    func(f *testFixture) *ent.User { return f.owner },
+			deleted: true,
+		},
+		{
+			name:     "admin restores a todo that is not deleted",
+			user:     func(f *testFixture) *ent.User { return f.owner },
+			wantCode: ErrCodeNotFound,
+		},
+		{
+			name:     "member restores their deleted todo",
+			user:     func(f *testFixture) *ent.User { return f.member },
+			deleted:  true,
+			wantCode: ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestFixture(t)
+
+			// only the owner is one of the configured admins
+			f.handler = NewResolver(f.client).WithSettings(config.GraphQL{Admins: []string{f.owner.ID}}).Handler(false)
+
+			if tt.deleted {
+				f.client.Todo.DeleteOne(f.memberTodo).ExecX(testutils.UserContext(f.member.ID, f.org.ID))
+			}
+
+			res := f.query(t, tt.user(f), f.org, `mutation($id: ID!) { restoreTodo(id: $id) { todo { id } } }`,
+				map[string]any{"id": f.memberTodo.ID})
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+
+				if tt.deleted {
+					// the todo is still deleted
+					_, err := f.client.Todo.Get(testutils.UserContext(f.member.ID, f.org.ID), f.memberTodo.ID)
+					assert.True(t, ent.IsNotFound(err))
+				}
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			_, err := f.client.Todo.Get(testutils.UserContext(f.member.ID, f.org.ID), f.memberTodo.ID)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTodosConnection(t *testing.T) {
+	f := newTestFixture(t)
+
+	ctx := testutils.UserContext(f.member.ID, f.org.ID)
+
+	f.client.Todo.Create().SetName("alpha").SetPriority(1).SetStatus(todo.StatusDONE).SaveX(ctx)
+	f.client.Todo.Create().SetName("bravo").SetPriority(3).SaveX(ctx)
+	f.client.Todo.Create().SetName("charlie").SetPriority(2).SetStatus(todo.StatusBLOCKED).SaveX(ctx)
+
+	const query = `query($first: Int, $last: Int, $after: Cursor, $orderBy: TodoOrder, $where: TodoWhereInput) {
+		todos(first: $first, last: $last, after: $after, orderBy: $orderBy, where: $where) {
+			totalCount
+			pageInfo { hasNextPage hasPreviousPage endCursor }
+			edges { node { name } }
+		}
+	}`
+
+	byName := map[string]any{"field": "name", "direction": "ASC"}
+
+	tests := []struct {
+		name          string
+		variables     map[string]any
+		wantNames     []string
+		wantTotal     int
+		wantNext      bool
+		wantPrevious  bool
+		wantErrorCode string
+	}{
+		{
+			name:      "first page",
+			variables: map[string]any{"first": 2, "orderBy": byName},
+			wantNames: []string{"admin todo", "alpha"},
+			wantTotal: 5,
+			wantNext:  true,
+		},
+		{
+			name:      "descending",
+			variables: map[string]any{"first": 2, "orderBy": map[string]any{"field": "name", "direction": "DESC"}},
+			wantNames: []string{"member todo", "charlie"},
+			wantTotal: 5,
+			wantNext:  true,
+		},
+		{
+			name:         "last page",
+			variables:    map[string]any{"last": 2, "orderBy": byName},
+			wantNames:    []string{"charlie", "member todo"},
+			wantTotal:    5,
+			wantPrevious: true,
+		},
+		{
+			name:      "filtered by status",
+			variables: map[string]any{"where": map[string]any{"status": "DONE"}},
+			wantNames: []string{"alpha"},
+			wantTotal: 1,
+		},
+		{
+			name: "filtered and ordered by priority",
+			variables: map[string]any{
+				"where":   map[string]any{"priorityGTE": 2},
+				"orderBy": map[string]any{"field": "priority", "direction": "DESC"},
+			},
+			wantNames: []string{"bravo", "charlie"},
+			wantTotal: 2,
+		},
+		{
+			name: "combined filters",
+			variables: map[string]any{
+				"where":   map[string]any{"or": []map[string]any{{"nameHasPrefix": "a"}, {"statusIn": []string{"BLOCKED"}}}},
+				"orderBy": byName,
+			},
+			wantNames: []string{"admin todo", "alpha", "charlie"},
+			wantTotal: 3,
+		},
+		{
+			name:          "invalid order field",
+			variables:     map[string]any{"orderBy": map[string]any{"field": "description", "direction": "ASC"}},
+			wantErrorCode: "GRAPHQL_VALIDATION_FAILED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := f.query(t, f.member, f.org, query, tt.variables)
+
+			if tt.wantErrorCode != "" {
+				assert.Equal(t, tt.wantErrorCode, res.errorCode())
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+
+			conn := res.Data["todos"].(map[string]any)
+			assert.EqualValues(t, tt.wantTotal, conn["totalCount"])
+			assert.Equal(t, tt.wantNames, edgeNames(conn))
+
+			pageInfo := conn["pageInfo"].(map[string]any)
+			assert.Equal(t, tt.wantNext, pageInfo["hasNextPage"])
+			assert.Equal(t, tt.wantPrevious, pageInfo["hasPreviousPage"])
+		})
+	}
+
+	t.Run("pages with the cursor", func(t *testing.T) {
+		var (
+			names []string
+			after any
+		)
+
+		for range 3 {
+			res := f.query(t, f.member, f.org, query, map[string]any{"first": 2, "after": after, "orderBy": byName})
+			require.Empty(t, res.Errors)
+
+			conn := res.Data["todos"].(map[string]any)
+			names = append(names, edgeNames(conn)...)
+
+			pageInfo := conn["pageInfo"].(map[string]any)
+			if !pageInfo["hasNextPage"].(bool) {
+				break
+			}
+
+			after = pageInfo["endCursor"]
+		}
+
+		assert.Equal(t, []string{"admin todo", "alpha", "bravo", "charlie", "member todo"}, names)
+	})
+}
+
+// edgeNames returns the name of the node of each edge of the connection
+func edgeNames(conn map[string]any) []string {
+	names := []string{}
+
+	for _, e := range conn["edges"].([]any) {
+		names = append(names, e.(map[string]any)["node"].(map[string]any)["name"].(string))
+	}
+
+	return names
+}
@@ -0,0 +1,251 @@
+package graphapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/mitchellh/mapstructure"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// graphqlFileExtension is the extension of the files loaded from the trusted documents directory
+const graphqlFileExtension = ".graphql"
+
+// TrustedDocuments is a graphql extension that only allows operations from an allow-list, operations
+// can be sent as a full query or by their hash using the persistedQuery extension
+type TrustedDocuments struct {
+	// documents maps the hash of each trusted document to the document
+	documents map[string]string
+	// normalized contains the hash of the normalized form of each trusted document
+	normalized map[string]struct{}
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = &TrustedDocuments{}
+
+// NewTrustedDocumentsFromDir loads the trusted documents from the .graphql files in the directory,
+// each operation in a file is added to the allow-list with the fragments it uses
+func NewTrustedDocumentsFromDir(dir string) (*TrustedDocuments, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+graphqlFileExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	td := newTrustedDocuments()
+
+	for _, f := range files {
+		input, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		doc, err := parser.ParseQuery(&ast.Source{Name: f, Input: string(input)})
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", f, err)
+		}
+
+		for _, op := range doc.Operations {
+			document := normalizeDocument(operationDocument(doc, op))
+
+			td.documents[hashDocument(document)] = document
+			td.normalized[hashDocument(document)] = struct{}{}
+		}
+	}
+
+	if len(td.documents) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrNoTrustedDocuments, dir)
+	}
+
+	return td, nil
+}
+
+// NewTrustedDocumentsFromManifest loads the trusted documents from a JSON manifest mapping the hash
+// of each document to the document
+func NewTrustedDocumentsFromManifest(path string) (*TrustedDocuments, error) {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := map[string]string{}
+	if err := json.Unmarshal(input, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	td := newTrustedDocuments()
+
+	for hash, document := range manifest {
+		doc, err := parser.ParseQuery(&ast.Source{Name: hash, Input: document})
+		if err != nil {
+			return nil, fmt.Errorf("parsing document %s: %w", hash, err)
+		}
+
+		td.documents[hash] = document
+		td.normalized[hashDocument(normalizeDocument(doc))] = struct{}{}
+	}
+
+	if len(td.documents) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrNoTrustedDocuments, path)
+	}
+
+	return td, nil
+}
+
+// newTrustedDocuments returns an empty allow-list
+func newTrustedDocuments() *TrustedDocuments {
+	return &TrustedDocuments{
+		documents:  map[string]string{},
+		normalized: map[string]struct{}{},
+	}
+}
+
+// Manifest returns the trusted documents keyed by their hash
+func (t *TrustedDocuments) Manifest() map[string]string {
+	return t.documents
+}
+
+// ExtensionName returns the name of the extension
+func (t *TrustedDocuments) ExtensionName() string {
+	return "TrustedDocuments"
+}
+
+// Validate is a no-op, the documents are loaded when the extension is created
+func (t *TrustedDocuments) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters resolves the query of persisted operations and rejects any operation
+// that is not in the allow-list
+func (t *TrustedDocuments) MutateOperationParameters(_ context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if rawParams.Query == "" {
+		hash, err := persistedQueryHash(rawParams)
+		if err != nil {
+			return err
+		}
+
+		document, ok := t.documents[hash]
+		if !ok {
+			err := gqlerror.Errorf("persisted query not found")
+			errcode.Set(err, ErrCodePersistedQueryNotFound)
+
+			return err
+		}
+
+		rawParams.Query = document
+
+		return nil
+	}
+
+	doc, parseErr := parser.ParseQuery(&ast.Source{Input: rawParams.Query})
+	if parseErr == nil {
+		if _, ok := t.normalized[hashDocument(normalizeDocument(doc))]; ok {
+			return nil
+		}
+	}
+
+	err := gqlerror.Errorf("operation is not in the list of trusted documents")
+	errcode.Set(err, ErrCodeOperationNotTrusted)
+
+	return err
+}
+
+// persistedQueryHash returns the hash sent with the persistedQuery extension of the request
+func persistedQueryHash(rawParams *graphql.RawParams) (string, *gqlerror.Error) {
+	var extension struct {
+		Sha256 string `mapstructure:"sha256Hash"`
+	}
+
+	if rawParams.Extensions["persistedQuery"] == nil {
+		err := gqlerror.Errorf("no query or persisted query hash provided")
+		errcode.Set(err, ErrCodeOperationNotTrusted)
+
+		return "", err
+	}
+
+	if err := mapstructure.Decode(rawParams.Extensions["persistedQuery"], &extension); err != nil || extension.Sha256 == "" {
+		return "", gqlerror.Errorf("invalid persisted query extension data")
+	}
+
+	return strings.ToLower(extension.Sha256), nil
+}
+
+// operationDocument returns a document containing the operation and the fragments it uses
+func operationDocument(doc *ast.QueryDocument, op *ast.OperationDefinition) *ast.QueryDocument {
+	opDoc := &ast.QueryDocument{
+		Operations: ast.OperationList{op},
+	}
+
+	used := map[string]bool{}
+
+	var collect func(ast.SelectionSet)
+
+	collect = func(selectionSet ast.SelectionSet) {
+		for _, selection := range selectionSet {
+			switch s := selection.(type) {
+			case *ast.Field:
+				collect(s.SelectionSet)
+			case *ast.InlineFragment:
+				collect(s.SelectionSet)
+			case *ast.FragmentSpread:
+				if used[s.Name] {
+					continue
+				}
+
+				used[s.Name] = true
+
+				if f := doc.Fragments.ForName(s.Name); f != nil {
+					opDoc.Fragments = append(opDoc.Fragments, f)
+					collect(f.SelectionSet)
+				}
+			}
+		}
+	}
+
+	collect(op.SelectionSet)
+
+	return opDoc
+}
+
+// normalizeDocument returns the document in a canonical format, with the operations and fragments
+// sorted by name, so the same operation sent by different clients results in the same hash
+func normalizeDocument(doc *ast.QueryDocument) string {
+	sorted := &ast.QueryDocument{
+		Operations: append(ast.OperationList{}, doc.Operations...),
+		Fragments:  append(ast.FragmentDefinitionList{}, doc.Fragments...),
+	}
+
+	sort.SliceStable(sorted.Operations, func(i, j int) bool {
+		return sorted.Operations[i].Name < sorted.Operations[j].Name
+	})
+
+	sort.SliceStable(sorted.Fragments, func(i, j int) bool {
+		return sorted.Fragments[i].Name < sorted.Fragments[j].Name
+	})
+
+	var sb strings.Builder
+
+	formatter.NewFormatter(&sb).FormatQueryDocument(sorted)
+
+	return sb.String()
+}
+
+// hashDocument returns the hex encoded sha256 hash of the document
+func hashDocument(document string) string {
+	b := sha256.Sum256([]byte(document))
+
+	return hex.EncodeToString(b[:])
+}
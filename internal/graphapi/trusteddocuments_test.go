@@ -0,0 +1,264 @@
+package graphapi
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/config"
+)
+
+// trustedQuery is the operation of the trusted documents directory in the tests
+const trustedQuery = `query GetTodos {
+	todos { totalCount edges { node { ...TodoFields } } }
+}
+
+fragment TodoFields on Todo { id name }
+
+fragment UnusedFields on Todo { description }`
+
+// writeTrustedDocuments writes the documents to .graphql files in a new directory and returns the directory
+func writeTrustedDocuments(t *testing.T, documents ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for i, document := range documents {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "operations"+string(rune('a'+i))+graphqlFileExtension), []byte(document), 0600))
+	}
+
+	return dir
+}
+
+// documentHash returns the hash of the normalized document
+func documentHash(t *testing.T, document string) string {
+	t.Helper()
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: document})
+	require.NoError(t, err)
+
+	return hashDocument(normalizeDocument(doc))
+}
+
+func TestNormalizeDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		wantSame bool
+	}{
+		{
+			name:     "formatting",
+			a:        `query GetTodo($id: ID!) { todo(id: $id) { id name } }`,
+			b:        "query GetTodo($id: ID!) {\n  todo(id: $id) {\n    id\n\n    name\n  }\n}",
+			wantSame: true,
+		},
+		{
+			name:     "comments",
+			a:        `query GetTodo($id: ID!) { todo(id: $id) { id } }`,
+			b:        "# the todo\nquery GetTodo($id: ID!) { todo(id: $id) { id } }",
+			wantSame: true,
+		},
+		{
+			name:     "order of the fragments",
+			a:        `query GetTodo { todo(id: "1") { ...A ...B } } fragment A on Todo { id } fragment B on Todo { name }`,
+			b:        `fragment B on Todo { name } query GetTodo { todo(id: "1") { ...A ...B } } fragment A on Todo { id }`,
+			wantSame: true,
+		},
+		{
+			name:     "different fields",
+			a:        `query GetTodo($id: ID!) { todo(id: $id) { id } }`,
+			b:        `query GetTodo($id: ID!) { todo(id: $id) { id name } }`,
+			wantSame: false,
+		},
+		{
+			name:     "different operation names",
+			a:        `query GetTodo($id: ID!) { todo(id: $id) { id } }`,
+			b:        `query Todo($id: ID!) { todo(id: $id) { id } }`,
+			wantSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantSame, documentHash(t, tt.a) == documentHash(t, tt.b))
+		})
+	}
+}
+
+func TestNewTrustedDocumentsFromDir(t *testing.T) {
+	tests := []struct {
+		name          string
+		documents     []string
+		wantDocuments []string
+		wantErr       error
+	}{
+		{
+			name:          "operation with fragments",
+			documents:     []string{trustedQuery},
+			wantDocuments: []string{"query GetTodos {\n\ttodos {\n\t\ttotalCount\n\t\tedges {\n\t\t\tnode {\n\t\t\t\t... TodoFields\n\t\t\t}\n\t\t}\n\t}\n}\nfragment TodoFields on Todo {\n\tid\n\tname\n}\n"},
+		},
+		{
+			name: "operations of multiple files",
+			documents: []string{
+				`query GetTodo($id: ID!) { todo(id: $id) { id } } mutation DeleteTodo($id: ID!) { deleteTodo(id: $id) { deletedID } }`,
+				`query GetTags { tags { totalCount } }`,
+			},
+			wantDocuments: []string{
+				"query GetTodo ($id: ID!) {\n\ttodo(id: $id) {\n\t\tid\n\t}\n}\n",
+				"mutation DeleteTodo ($id: ID!) {\n\tdeleteTodo(id: $id) {\n\t\tdeletedID\n\t}\n}\n",
+				"query GetTags {\n\ttags {\n\t\ttotalCount\n\t}\n}\n",
+			},
+		},
+		{
+			name:    "empty directory",
+			wantErr: ErrNoTrustedDocuments,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td, err := NewTrustedDocumentsFromDir(writeTrustedDocuments(t, tt.documents...))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			want := map[string]string{}
+			for _, document := range tt.wantDocuments {
+				want[hashDocument(document)] = document
+			}
+
+			assert.Equal(t, want, td.Manifest())
+		})
+	}
+
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := NewTrustedDocumentsFromDir(writeTrustedDocuments(t, `query { todos {`))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewTrustedDocumentsFromManifest(t *testing.T) {
+	td, err := NewTrustedDocumentsFromDir(writeTrustedDocuments(t, trustedQuery))
+	require.NoError(t, err)
+
+	out, err := json.Marshal(td.Manifest())
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "trusted-documents.json")
+	require.NoError(t, os.WriteFile(path, out, 0600))
+
+	// the manifest generated from the directory loads the same documents
+	got, err := NewTrustedDocumentsFromManifest(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, td.Manifest(), got.Manifest())
+	assert.Equal(t, td.normalized, got.normalized)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{}`), 0600))
+
+	_, err = NewTrustedDocumentsFromManifest(path)
+	assert.ErrorIs(t, err, ErrNoTrustedDocuments)
+}
+
+func TestTrustedDocuments(t *testing.T) {
+	f := newTestFixture(t)
+
+	dir := writeTrustedDocuments(t, trustedQuery)
+
+	td, err := NewTrustedDocumentsFromDir(dir)
+	require.NoError(t, err)
+	require.Len(t, td.Manifest(), 1)
+
+	var hash string
+	for h := range td.Manifest() {
+		hash = h
+	}
+
+	persisted := func(hash string) map[string]any {
+		return map[string]any{
+			"extensions": map[string]any{
+				"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		disabled bool
+		params   map[string]any
+		wantCode string
+	}{
+		{
+			name:   "trusted query",
+			params: map[string]any{"query": td.Manifest()[hash]},
+		},
+		{
+			name:   "trusted query formatted differently",
+			params: map[string]any{"query": "query GetTodos { todos { totalCount edges { node { ...TodoFields } } } } fragment TodoFields on Todo { id name }"},
+		},
+		{
+			name:     "query that is not trusted",
+			params:   map[string]any{"query": `query GetTodos { todos { totalCount } }`},
+			wantCode: ErrCodeOperationNotTrusted,
+		},
+		{
+			name:   "hash of a trusted query",
+			params: persisted(hash),
+		},
+		{
+			name:     "unknown hash",
+			params:   persisted(hashDocument("query { todos { totalCount } }")),
+			wantCode: ErrCodePersistedQueryNotFound,
+		},
+		{
+			name:     "without a query or hash",
+			params:   map[string]any{},
+			wantCode: ErrCodeOperationNotTrusted,
+		},
+		{
+			name:     "disabled",
+			disabled: true,
+			params:   map[string]any{"query": `query GetTodos { todos { totalCount } }`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := config.GraphQL{
+				TrustedDocuments: config.TrustedDocuments{
+					Enabled:   !tt.disabled,
+					Directory: dir,
+				},
+			}
+
+			f.handler = NewResolver(f.client).WithLogger(zap.NewNop().Sugar()).WithSettings(settings).Handler(false)
+
+			rec := f.sendParams(t, f.member, f.org, tt.params)
+
+			var res graphResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, res.errorCode())
+				assert.Nil(t, res.Data)
+
+				return
+			}
+
+			require.Empty(t, res.Errors)
+			assert.EqualValues(t, 2, res.Data["todos"].(map[string]any)["totalCount"])
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+var (
+	// ErrProcessingRequest is returned when the request cannot be processed
+	ErrProcessingRequest = errors.New("error processing request, please try again")
+	// ErrInvalidCredentials is returned when the email address or password is invalid or missing
+	ErrInvalidCredentials = errors.New("credentials are missing or invalid")
+	// ErrNoOrganization is returned when the user logging in is not a member of any organization
+	ErrNoOrganization = errors.New("user is not a member of any organization")
+	// ErrInvalidRefreshToken is returned when the refresh token is invalid, expired, or was already used
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or was already used")
+	// ErrRevocationNotConfigured is returned when revoking tokens without a revocation list, e.g. when redis is disabled
+	ErrRevocationNotConfigured = errors.New("tokens can not be revoked, the revocation list is not configured")
+	// ErrInvalidToken is returned when the token to revoke can not be parsed
+	ErrInvalidToken = errors.New("token is missing or invalid")
+	// ErrUnsupportedProvider is returned when logging in with an oauth2 provider that is not supported
+	ErrUnsupportedProvider = errors.New("oauth2 provider not supported")
+	// ErrProviderNotConfigured is returned when a supported oauth2 provider has no client id
+	ErrProviderNotConfigured = errors.New("oauth2 provider is missing the client id")
+	// ErrInvalidOauthState is returned when the state of the oauth2 callback does not match the state of the session
+	ErrInvalidOauthState = errors.New("oauth2 state is missing or invalid, please try to log in again")
+	// ErrOauthFailed is returned when the user could not be authenticated with the oauth2 provider
+	ErrOauthFailed = errors.New("unable to authenticate with the oauth2 provider")
+	// ErrPasswordAccount is returned when logging in with an oauth2 provider as the user of an account with a password
+	ErrPasswordAccount = errors.New("an account with the email address already exists, log in with the password instead")
+	// ErrNoVerifiedEmail is returned when the oauth2 provider has no verified email address of the user
+	ErrNoVerifiedEmail = errors.New("oauth2 provider has no verified email address for the user")
+	// ErrOrganizationNameTaken is returned when no unique name could be found for the organization of a new user
+	ErrOrganizationNameTaken = errors.New("unable to find a unique name for the organization of the user")
+)
+
+// InvalidInputErrCode is returned when the input is invalid
+var InvalidInputErrCode rout.ErrorCode = "INVALID_INPUT"
+
+// InternalServerError returns a 500 Internal Server Error response with the error message
+func (h *Handler) InternalServerError(ctx echo.Context, err error) error {
+	if err := ctx.JSON(http.StatusInternalServerError, rout.ErrorResponse(err)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Unauthorized returns a 401 Unauthorized response with the error message
+func (h *Handler) Unauthorized(ctx echo.Context, err error) error {
+	if err := ctx.JSON(http.StatusUnauthorized, rout.ErrorResponse(err)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// BadRequest returns a 400 Bad Request response with the error message
+func (h *Handler) BadRequest(ctx echo.Context, err error) error {
+	if err := ctx.JSON(http.StatusBadRequest, rout.ErrorResponse(err)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// InvalidInput returns a 400 Bad Request response with the error message and the invalid input code
+func (h *Handler) InvalidInput(ctx echo.Context, err error) error {
+	if err := ctx.JSON(http.StatusBadRequest, rout.ErrorResponseWithCode(err, InvalidInputErrCode)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Conflict returns a 409 Conflict response with the error message
+func (h *Handler) Conflict(ctx echo.Context, err error) error {
+	if err := ctx.JSON(http.StatusConflict, rout.ErrorResponse(err)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// NotImplemented returns a 501 Not Implemented response with the error message
+func (h *Handler) NotImplemented(ctx echo.Context, err error) error {
+	if err := ctx.JSON(http.StatusNotImplemented, rout.ErrorResponse(err)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Success returns a 200 OK response with the body
+func (h *Handler) Success(ctx echo.Context, body any) error {
+	return ctx.JSON(http.StatusOK, body)
+}
@@ -5,13 +5,13 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
-	"github.com/datumforge/datum/pkg/providers/github"
-	"github.com/datumforge/datum/pkg/providers/google"
 	"github.com/datumforge/datum/pkg/providers/webauthn"
 	"github.com/datumforge/datum/pkg/sessions"
+	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 
 	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/pkg/revocation"
 )
 
 // Handler contains configuration options for handlers
@@ -32,8 +32,16 @@ type Handler struct {
 	AuthMiddleware []echo.MiddlewareFunc
 	// JWTKeys contains the set of valid JWT authentication key
 	JWTKeys jwk.Set
+	// TokenManager creates and verifies the JWT tokens issued by the server
+	TokenManager *tokens.TokenManager
+	// TokenConfig contains the settings of the tokens issued by the server, e.g. the issuer and the JWKS endpoint
+	TokenConfig tokens.Config
+	// TokenRevocations is the list of revoked tokens, refresh tokens are revoked once they are used
+	TokenRevocations *revocation.List
 	// OauthProvider contains the configuration settings for all supported Oauth2 providers
 	OauthProvider OauthProviderConfig
+	// SupportedProviders are the names of the Oauth2 providers users can log in with, e.g. github
+	SupportedProviders []string
 }
 
 // OauthProviderConfig represents the configuration for OAuth providers such as Github and Google
@@ -41,9 +49,51 @@ type OauthProviderConfig struct {
 	// RedirectURL is the URL that the OAuth2 client will redirect to after authentication with datum
 	RedirectURL string `json:"redirectUrl" koanf:"redirectUrl" default:"http://localhost:3001/api/auth/callback/datum"`
 	// Github contains the configuration settings for the Github Oauth Provider
-	Github github.ProviderConfig `json:"github" koanf:"github"`
+	Github GithubProviderConfig `json:"github" koanf:"github"`
+	// GithubEndpoints overrides the endpoints of the Github Oauth Provider, e.g. for GitHub Enterprise
+	GithubEndpoints ProviderEndpoints `json:"githubEndpoints" koanf:"githubEndpoints"`
 	// Google contains the configuration settings for the Google Oauth Provider
-	Google google.ProviderConfig `json:"google" koanf:"google"`
+	Google GoogleProviderConfig `json:"google" koanf:"google"`
+	// GoogleEndpoints overrides the endpoints of the Google Oauth Provider
+	GoogleEndpoints ProviderEndpoints `json:"googleEndpoints" koanf:"googleEndpoints"`
 	// Webauthn contains the configuration settings for the Webauthn Oauth Provider
 	Webauthn webauthn.ProviderConfig `json:"webauthn" koanf:"webauthn"`
 }
+
+// GithubProviderConfig represents the configuration settings of the Github Oauth Provider
+type GithubProviderConfig struct {
+	// ClientID is the public identifier for the GitHub oauth2 client
+	ClientID string `json:"clientId" koanf:"clientId" jsonschema:"required"`
+	// ClientSecret is the secret for the GitHub oauth2 client
+	ClientSecret string `json:"clientSecret" koanf:"clientSecret" jsonschema:"required"`
+	// ClientEndpoint is the base url of this server, GitHub redirects back to it after authentication
+	ClientEndpoint string `json:"clientEndpoint" koanf:"clientEndpoint" default:"http://localhost:1337"`
+	// Scopes are the scopes that the GitHub oauth2 client will request
+	Scopes []string `json:"scopes" koanf:"scopes" jsonschema:"required"`
+	// RedirectURL is the path of the callback GitHub redirects to after authentication
+	RedirectURL string `json:"redirectUrl" koanf:"redirectUrl" jsonschema:"required" default:"/v1/github/callback"`
+}
+
+// GoogleProviderConfig represents the configuration settings of the Google Oauth Provider
+type GoogleProviderConfig struct {
+	// ClientID is the public identifier for the Google oauth2 client
+	ClientID string `json:"clientId" koanf:"clientId" jsonschema:"required"`
+	// ClientSecret is the secret for the Google oauth2 client
+	ClientSecret string `json:"clientSecret" koanf:"clientSecret" jsonschema:"required"`
+	// ClientEndpoint is the base url of this server, Google redirects back to it after authentication
+	ClientEndpoint string `json:"clientEndpoint" koanf:"clientEndpoint" default:"http://localhost:1337"`
+	// Scopes are the scopes that the Google oauth2 client will request
+	Scopes []string `json:"scopes" koanf:"scopes" jsonschema:"required"`
+	// RedirectURL is the path of the callback Google redirects to after authentication
+	RedirectURL string `json:"redirectUrl" koanf:"redirectUrl" jsonschema:"required" default:"/v1/google/callback"`
+}
+
+// ProviderEndpoints are the endpoints of an Oauth2 provider, they only need to be set when they differ from the public service
+type ProviderEndpoints struct {
+	// AuthURL is the authorization endpoint of the provider
+	AuthURL string `json:"authUrl" koanf:"authUrl"`
+	// TokenURL is the token endpoint of the provider
+	TokenURL string `json:"tokenUrl" koanf:"tokenUrl"`
+	// APIURL is the base url of the api of the provider used to get the user
+	APIURL string `json:"apiUrl" koanf:"apiUrl"`
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/passwd"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/tokens"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/pkg/middleware/transaction"
+)
+
+// tokenType is the type of the access tokens issued by the server
+const tokenType = "bearer"
+
+// LoginHandler verifies the email address and password of the user and returns an access and refresh token
+// for the organization the user joined first
+func (h *Handler) LoginHandler(ctx echo.Context) error {
+	var in models.LoginRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	// the user is not authenticated yet, the user is looked up across all organizations
+	reqCtx := interceptors.SkipTenant(ctx.Request().Context())
+	client := transaction.FromContext(reqCtx).Client()
+
+	u, err := client.User.Query().
+		Where(user.Email(in.Username)).
+		Only(reqCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.BadRequest(ctx, ErrInvalidCredentials)
+		}
+
+		h.Logger.Errorw("error getting user", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	// users without a password can not log in
+	if u.Password == nil {
+		return h.BadRequest(ctx, ErrInvalidCredentials)
+	}
+
+	valid, err := passwd.VerifyDerivedKey(*u.Password, in.Password)
+	if err != nil || !valid {
+		return h.BadRequest(ctx, ErrInvalidCredentials)
+	}
+
+	orgID, err := defaultOrganization(reqCtx, client, u.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.BadRequest(ctx, ErrNoOrganization)
+		}
+
+		h.Logger.Errorw("error getting organization of user", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	accessToken, refreshToken, err := h.TokenManager.CreateTokenPair(newClaims(u.ID, orgID))
+	if err != nil {
+		h.Logger.Errorw("error creating token pair", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	out := &models.LoginReply{
+		Reply:   rout.Reply{Success: true},
+		Message: "success",
+		AuthData: models.AuthData{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			TokenType:    tokenType,
+		},
+	}
+
+	return h.Success(ctx, out)
+}
+
+// defaultOrganization returns the id of the organization the user joined first
+func defaultOrganization(ctx context.Context, client *ent.Client, userID string) (string, error) {
+	om, err := client.OrgMembership.Query().
+		Where(orgmembership.UserID(userID)).
+		Order(ent.Asc(orgmembership.FieldCreatedAt), ent.Asc(orgmembership.FieldID)).
+		First(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return om.OrganizationID, nil
+}
+
+// newClaims returns the claims of the tokens of the user for the organization, the subject is the id of the user
+func newClaims(userID, orgID string) *tokens.Claims {
+	claims := &tokens.Claims{
+		UserID: userID,
+		OrgID:  orgID,
+	}
+
+	claims.Subject = userID
+
+	return claims
+}
+
+// BindLoginHandler binds the login request to the OpenAPI schema
+func (h *Handler) BindLoginHandler() *openapi3.Operation {
+	login := openapi3.NewOperation()
+	login.Description = "Login verifies the email address and password of the user and returns an access token and a refresh token for the organization the user joined first. The access token authenticates the requests of the user until it expires, the refresh token can then be used with the refresh endpoint to get a new pair of tokens without logging in again"
+	login.OperationID = "LoginHandler"
+	login.Tags = []string{"authentication"}
+
+	h.AddRequestBody("LoginRequest", models.ExampleLoginSuccessRequest, login)
+	h.AddResponse("LoginReply", "success", models.ExampleLoginSuccessResponse, login, http.StatusOK)
+	login.AddResponse(http.StatusInternalServerError, internalServerError())
+	login.AddResponse(http.StatusBadRequest, badRequest())
+
+	return login
+}
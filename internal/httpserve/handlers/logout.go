@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/tokens"
+
+	"github.com/datumforge/go-template/pkg/revocation"
+)
+
+// expiredMessage is the message of the logout response when the tokens have already expired, there is nothing to revoke
+const expiredMessage = "tokens already expired"
+
+// LogoutRequest holds the fields of a request to the logout endpoint
+type LogoutRequest struct {
+	// RefreshToken is the refresh token to revoke, the access token of the request is revoked when it is not set
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+// LogoutReply holds the fields of the response of the logout endpoint
+type LogoutReply struct {
+	rout.Reply
+	Message string `json:"message"`
+}
+
+// ExampleLogoutRequest is an example of a logout request for OpenAPI documentation
+var ExampleLogoutRequest = LogoutRequest{
+	RefreshToken: "refresh_token",
+}
+
+// ExampleLogoutSuccessResponse is an example of a successful logout response for OpenAPI documentation
+var ExampleLogoutSuccessResponse = LogoutReply{
+	Reply:   rout.Reply{Success: true},
+	Message: "success",
+}
+
+// LogoutHandler revokes the access token and the refresh token issued together, either the refresh token
+// in the request or the bearer access token of the request identifies them; an expired access token can be
+// revoked too, so its refresh token can not be used anymore. The signature and claims of the token are verified,
+// apart from its expiration, before it is revoked. When the refresh token has expired as well
+// nothing is revoked and the reply says so
+func (h *Handler) LogoutHandler(ctx echo.Context) error {
+	var in LogoutRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if h.TokenRevocations == nil {
+		return h.NotImplemented(ctx, ErrRevocationNotConfigured)
+	}
+
+	token := in.RefreshToken
+	if token == "" {
+		accessToken, err := auth.GetAccessToken(ctx)
+		if err != nil {
+			return h.Unauthorized(ctx, ErrInvalidToken)
+		}
+
+		token = accessToken
+	}
+
+	claims, err := h.verifyLogoutToken(token)
+	if err != nil {
+		return h.Unauthorized(ctx, ErrInvalidToken)
+	}
+
+	// the tokens share the id, it is revoked until the refresh token expires
+	expiresAt := claims.ExpiresAt.Time
+	if claims.IssuedAt != nil {
+		if refreshExpiresAt := claims.IssuedAt.Add(h.TokenManager.Config().RefreshDuration); refreshExpiresAt.After(expiresAt) {
+			expiresAt = refreshExpiresAt
+		}
+	}
+
+	_, err = h.TokenRevocations.Revoke(ctx.Request().Context(), claims.ID, expiresAt)
+	if errors.Is(err, revocation.ErrExpired) {
+		return h.Success(ctx, &LogoutReply{
+			Reply:   rout.Reply{Success: true},
+			Message: expiredMessage,
+		})
+	}
+
+	if err != nil {
+		h.Logger.Errorw("error revoking tokens", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	return h.Success(ctx, &LogoutReply{
+		Reply:   rout.Reply{Success: true},
+		Message: "success",
+	})
+}
+
+// verifyLogoutToken verifies the signature and claims of the token to revoke, the same as the token manager except
+// for the expiration and not before times: expired tokens and refresh tokens that can not be used yet can still be
+// revoked. The token has to be issued by the server for its audience, with an id and subject
+func (h *Handler) verifyLogoutToken(token string) (*tokens.Claims, error) {
+	claims, err := h.TokenManager.Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := h.TokenManager.Config()
+
+	switch {
+	case claims.ID == "" || claims.Subject == "" || claims.ExpiresAt == nil:
+		return nil, tokens.ErrTokenInvalidClaims
+	case !claims.VerifyAudience(conf.Audience, true):
+		return nil, tokens.ErrTokenInvalidAudience
+	case !claims.VerifyIssuer(conf.Issuer, true):
+		return nil, tokens.ErrTokenInvalidIssuer
+	case claims.IssuedAt != nil && claims.IssuedAt.After(time.Now()):
+		return nil, tokens.ErrTokenInvalidClaims
+	}
+
+	return claims, nil
+}
+
+// BindLogoutHandler binds the logout request to the OpenAPI schema
+func (h *Handler) BindLogoutHandler() *openapi3.Operation {
+	logout := openapi3.NewOperation()
+	logout.Description = "Logout revokes the access token and the refresh token issued together so neither can be used anymore. The tokens are identified by the refresh token in the request, or by the bearer access token when no refresh token is sent. When the tokens have already expired nothing is revoked and the message of the reply is \"tokens already expired\""
+	logout.OperationID = "LogoutHandler"
+	logout.Tags = []string{"authentication"}
+	logout.Security = &openapi3.SecurityRequirements{
+		openapi3.SecurityRequirement{
+			"bearerAuth": []string{},
+		},
+	}
+
+	h.AddRequestBody("LogoutRequest", ExampleLogoutRequest, logout)
+	h.AddResponse("LogoutReply", "success", ExampleLogoutSuccessResponse, logout, http.StatusOK)
+	logout.AddResponse(http.StatusInternalServerError, internalServerError())
+	logout.AddResponse(http.StatusBadRequest, badRequest())
+	logout.AddResponse(http.StatusUnauthorized, unauthorized())
+	logout.AddResponse(http.StatusNotImplemented, notImplemented())
+
+	return logout
+}
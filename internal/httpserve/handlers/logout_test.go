@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	echo "github.com/datumforge/echox"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/tokens"
+
+	"github.com/datumforge/go-template/pkg/revocation"
+)
+
+// newTokenManager returns a token manager signing with a generated key
+func newTokenManager(t *testing.T) *tokens.TokenManager {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tm, err := tokens.NewWithKey(key, tokens.Config{
+		Audience:        "https://datum.net",
+		Issuer:          "https://auth.datum.net",
+		AccessDuration:  time.Hour,
+		RefreshDuration: 2 * time.Hour,
+		RefreshOverlap:  -15 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	return tm
+}
+
+// signToken returns a token of the user issued at the time, it expires after the access duration
+func signToken(t *testing.T, tm *tokens.TokenManager, id string, issuedAt time.Time) string {
+	t.Helper()
+
+	claims := newClaims("user_01", "org_01")
+	claims.ID = id
+	claims.IssuedAt = jwt.NewNumericDate(issuedAt)
+	claims.ExpiresAt = jwt.NewNumericDate(issuedAt.Add(tm.Config().AccessDuration))
+
+	token, err := tm.Sign(tm.CreateToken(claims))
+	require.NoError(t, err)
+
+	return token
+}
+
+// signClaims returns a token of the user issued now with the claims changed by the function
+func signClaims(t *testing.T, tm *tokens.TokenManager, change func(*tokens.Claims)) string {
+	t.Helper()
+
+	claims := newClaims("user_01", "org_01")
+	claims.ID = "changed_claims"
+	claims.IssuedAt = jwt.NewNumericDate(time.Now())
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(tm.Config().AccessDuration))
+
+	change(claims)
+
+	token, err := tm.Sign(tm.CreateToken(claims))
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestLogoutHandler(t *testing.T) {
+	tm := newTokenManager(t)
+
+	accessToken, refreshToken, err := tm.CreateTokenPair(newClaims("user_01", "org_01"))
+	require.NoError(t, err)
+
+	refreshClaims, err := tm.Parse(refreshToken)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		body         string
+		accessToken  string
+		noRevocation bool
+		wantStatus   int
+		wantMessage  string
+		wantRevoked  string
+	}{
+		{
+			name:        "refresh token",
+			body:        `{"refresh_token": "` + refreshToken + `"}`,
+			wantStatus:  http.StatusOK,
+			wantMessage: "success",
+			wantRevoked: refreshClaims.ID,
+		},
+		{
+			name:        "access token of the request",
+			body:        `{}`,
+			accessToken: accessToken,
+			wantStatus:  http.StatusOK,
+			wantMessage: "success",
+			wantRevoked: refreshClaims.ID,
+		},
+		{
+			name:        "expired access token before the refresh token expires",
+			body:        `{}`,
+			accessToken: signToken(t, tm, "access_expired", time.Now().Add(-90*time.Minute)),
+			wantStatus:  http.StatusOK,
+			wantMessage: "success",
+			wantRevoked: "access_expired",
+		},
+		{
+			name:        "expired refresh token",
+			body:        `{"refresh_token": "` + signToken(t, tm, "refresh_expired", time.Now().Add(-3*time.Hour)) + `"}`,
+			wantStatus:  http.StatusOK,
+			wantMessage: expiredMessage,
+		},
+		{
+			name:       "token for another audience",
+			body:       `{"refresh_token": "` + signClaims(t, tm, func(c *tokens.Claims) { c.Audience = jwt.ClaimStrings{"https://other.net"} }) + `"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token of another issuer",
+			body:       `{"refresh_token": "` + signClaims(t, tm, func(c *tokens.Claims) { c.Issuer = "https://auth.other.net" }) + `"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token without an id",
+			body:       `{"refresh_token": "` + signClaims(t, tm, func(c *tokens.Claims) { c.ID = "" }) + `"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid token",
+			body:       `{"refresh_token": "invalid"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "no token",
+			body:       `{}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "tokens can not be revoked",
+			body:         `{"refresh_token": "` + refreshToken + `"}`,
+			noRevocation: true,
+			wantStatus:   http.StatusNotImplemented,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+
+			rc := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			t.Cleanup(func() { rc.Close() })
+
+			h := &Handler{
+				Logger:           zap.NewNop().Sugar(),
+				TokenManager:     tm,
+				TokenRevocations: revocation.New(rc),
+			}
+
+			if tt.noRevocation {
+				h.TokenRevocations = nil
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/logout", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			if tt.accessToken != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tt.accessToken)
+			}
+
+			rec := httptest.NewRecorder()
+
+			_ = h.LogoutHandler(echo.New().NewContext(req, rec))
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var out LogoutReply
+
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+			assert.True(t, out.Success)
+			assert.Equal(t, tt.wantMessage, out.Message)
+
+			// only the id of the tokens is revoked, expired tokens are not added to the list
+			if tt.wantRevoked == "" {
+				assert.Empty(t, mr.Keys())
+
+				return
+			}
+
+			revoked, err := h.TokenRevocations.IsRevoked(context.Background(), tt.wantRevoked)
+			require.NoError(t, err)
+			assert.True(t, revoked)
+		})
+	}
+}
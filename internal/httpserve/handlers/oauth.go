@@ -0,0 +1,472 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/keygen"
+	"github.com/datumforge/datum/pkg/sessions"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/organization"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/pkg/middleware/transaction"
+)
+
+const (
+	githubProvider = "github"
+	googleProvider = "google"
+
+	// githubAPIURL is the base url of the GitHub api
+	githubAPIURL = "https://api.github.com"
+	// googleAPIURL is the base url of the Google apis
+	googleAPIURL = "https://www.googleapis.com"
+
+	// oauthSessionName is the name of the session holding the state and PKCE verifier of the oauth2 flow in progress
+	oauthSessionName = "oauth_flow"
+	// oauthSessionMaxAge is the number of seconds the user has to authorize with the provider
+	oauthSessionMaxAge = 600
+	oauthStateKey      = "state"
+	oauthVerifierKey   = "verifier"
+	oauthStateLength   = 32
+
+	// organizationNameSuffixLength is the length of the random suffix added to the organization name of a new user
+	// when the email address is already the name of another organization
+	organizationNameSuffixLength = 6
+	// maxOrganizationNameAttempts is the number of names tried for the organization of a new user
+	maxOrganizationNameAttempts = 5
+)
+
+var (
+	// githubScopes are the scopes needed to get the user and its verified email addresses from GitHub
+	githubScopes = []string{"read:user", "user:email"}
+	// googleScopes are the scopes needed to get the user and its verified email address from Google
+	googleScopes = []string{"openid", "email", "profile"}
+)
+
+// oauthUser is the user authenticated with an oauth2 provider
+type oauthUser struct {
+	// Email is the verified email address of the user
+	Email string
+	// Name is the display name of the user
+	Name string
+}
+
+// oauthProvider is an oauth2 provider users can log in with
+type oauthProvider struct {
+	config *oauth2.Config
+	// getUser gets the user from the api of the provider, the client adds the access token to the requests
+	getUser func(ctx context.Context, client *http.Client) (*oauthUser, error)
+}
+
+// ValidateOauthProvider checks the provider is supported and configured
+func (h *Handler) ValidateOauthProvider(name string) error {
+	_, err := h.oauthProvider(name)
+
+	return err
+}
+
+// oauthProvider returns the provider with the name from the configuration, the endpoints of the public
+// service are used unless they are configured
+func (h *Handler) oauthProvider(name string) (*oauthProvider, error) {
+	var (
+		config    oauth2.Config
+		overrides ProviderEndpoints
+		apiURL    string
+		getUser   func(apiURL string) func(context.Context, *http.Client) (*oauthUser, error)
+	)
+
+	switch name {
+	case githubProvider:
+		c := h.OauthProvider.Github
+		config = oauth2.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+			Endpoint:     endpoints.GitHub,
+			RedirectURL:  callbackURL(name, c.ClientEndpoint, c.RedirectURL),
+			Scopes:       slices.Concat(githubScopes, c.Scopes),
+		}
+		overrides, apiURL, getUser = h.OauthProvider.GithubEndpoints, githubAPIURL, githubUser
+	case googleProvider:
+		c := h.OauthProvider.Google
+		config = oauth2.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+			Endpoint:     endpoints.Google,
+			RedirectURL:  callbackURL(name, c.ClientEndpoint, c.RedirectURL),
+			Scopes:       slices.Concat(googleScopes, c.Scopes),
+		}
+		overrides, apiURL, getUser = h.OauthProvider.GoogleEndpoints, googleAPIURL, googleUser
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedProvider, name)
+	}
+
+	if config.ClientID == "" {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotConfigured, name)
+	}
+
+	if overrides.AuthURL != "" {
+		config.Endpoint.AuthURL = overrides.AuthURL
+	}
+
+	if overrides.TokenURL != "" {
+		config.Endpoint.TokenURL = overrides.TokenURL
+	}
+
+	if overrides.APIURL != "" {
+		apiURL = overrides.APIURL
+	}
+
+	return &oauthProvider{
+		config:  &config,
+		getUser: getUser(apiURL),
+	}, nil
+}
+
+// callbackURL returns the url of the callback the provider redirects to, the path defaults to /v1/<provider>/callback
+func callbackURL(provider, clientEndpoint, redirectURL string) string {
+	if redirectURL == "" {
+		redirectURL = "/v1/" + provider + "/callback"
+	}
+
+	return clientEndpoint + redirectURL
+}
+
+// OauthLoginHandler starts the oauth2 flow with the provider, the state and the PKCE verifier are stored
+// in the session and the user is redirected to the provider to authorize the login
+func (h *Handler) OauthLoginHandler(ctx echo.Context, provider string) error {
+	p, err := h.oauthProvider(provider)
+	if err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
+	state := keygen.GenerateRandomString(oauthStateLength)
+	verifier := oauth2.GenerateVerifier()
+
+	sess := h.SessionConfig.SessionManager.New(oauthSessionName)
+	sess.Set(provider, map[string]any{
+		oauthStateKey:    state,
+		oauthVerifierKey: verifier,
+	})
+
+	value, err := h.SessionConfig.SessionManager.EncodeCookie(sess)
+	if err != nil {
+		h.Logger.Errorw("error encoding oauth session", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	h.setOauthSessionCookie(ctx, value, oauthSessionMaxAge)
+
+	return ctx.Redirect(http.StatusFound, p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)))
+}
+
+// OauthCallbackHandler completes the oauth2 flow with the provider: the code is exchanged for a token with the
+// PKCE verifier of the session, the user with the email address from the provider is created when it does not
+// exist yet, and the user is redirected to the configured url with our own access and refresh token as cookies
+func (h *Handler) OauthCallbackHandler(ctx echo.Context, provider string) error {
+	p, err := h.oauthProvider(provider)
+	if err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
+	sess, err := h.SessionConfig.SessionManager.Get(ctx.Request(), oauthSessionName)
+	if err != nil {
+		return h.BadRequest(ctx, ErrInvalidOauthState)
+	}
+
+	// the flow can only be completed once
+	h.setOauthSessionCookie(ctx, "", -1)
+
+	// the provider redirects back with an error when the user did not authorize the login
+	if reason := ctx.QueryParam("error"); reason != "" {
+		return h.Unauthorized(ctx, fmt.Errorf("%w: %s", ErrOauthFailed, reason))
+	}
+
+	flow, _ := sess.GetOk(provider)
+	state, _ := flow[oauthStateKey].(string)
+	verifier, _ := flow[oauthVerifierKey].(string)
+
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(ctx.QueryParam("state"))) != 1 {
+		return h.BadRequest(ctx, ErrInvalidOauthState)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	token, err := p.config.Exchange(reqCtx, ctx.QueryParam("code"), oauth2.VerifierOption(verifier))
+	if err != nil {
+		h.Logger.Errorw("error exchanging oauth code", "provider", provider, "error", err)
+
+		return h.Unauthorized(ctx, ErrOauthFailed)
+	}
+
+	ou, err := p.getUser(reqCtx, p.config.Client(reqCtx, token))
+	if err != nil {
+		h.Logger.Errorw("error getting oauth user", "provider", provider, "error", err)
+
+		return h.Unauthorized(ctx, ErrOauthFailed)
+	}
+
+	if ou.Email == "" {
+		return h.BadRequest(ctx, ErrNoVerifiedEmail)
+	}
+
+	userID, orgID, err := upsertOauthUser(interceptors.SkipTenant(reqCtx), transaction.FromContext(reqCtx).Client(), ou)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.BadRequest(ctx, ErrNoOrganization)
+		}
+
+		if errors.Is(err, ErrPasswordAccount) {
+			return h.Conflict(ctx, err)
+		}
+
+		h.Logger.Errorw("error upserting oauth user", "provider", provider, "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	accessToken, refreshToken, err := h.TokenManager.CreateTokenPair(newClaims(userID, orgID))
+	if err != nil {
+		h.Logger.Errorw("error creating token pair", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	auth.SetAuthCookies(ctx.Response().Writer, accessToken, refreshToken, *h.SessionConfig.CookieConfig)
+
+	return ctx.Redirect(http.StatusFound, h.OauthProvider.RedirectURL)
+}
+
+// setOauthSessionCookie sets the cookie of the oauth2 session, the cookie is removed when max age is negative
+func (h *Handler) setOauthSessionCookie(ctx echo.Context, value string, maxAge int) {
+	cc := *h.SessionConfig.CookieConfig
+	cc.MaxAge = maxAge
+	// the provider redirects back with a cross-site request, strict cookies would not be sent with it
+	cc.SameSite = http.SameSiteLaxMode
+
+	http.SetCookie(ctx.Response().Writer, sessions.NewCookie(oauthSessionName, value, &cc))
+}
+
+// upsertOauthUser returns the user with the email address and the organization the user joined first; new users
+// are created together with an organization of their own, existing users get the name from the provider when
+// they do not have a display name yet. Users with a password are not linked, the email address of the account
+// was never verified so the account may have been created by someone else to take over the login
+func upsertOauthUser(ctx context.Context, client *ent.Client, ou *oauthUser) (userID, orgID string, err error) {
+	u, err := client.User.Query().
+		Where(user.Email(ou.Email)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", "", err
+	}
+
+	if u != nil {
+		if u.Password != nil {
+			return "", "", ErrPasswordAccount
+		}
+
+		if u.DisplayName == "" && ou.Name != "" {
+			if err := client.User.UpdateOne(u).SetDisplayName(ou.Name).Exec(ctx); err != nil {
+				return "", "", err
+			}
+		}
+
+		orgID, err := defaultOrganization(ctx, client, u.ID)
+		if err != nil {
+			return "", "", err
+		}
+
+		return u.ID, orgID, nil
+	}
+
+	create := client.User.Create().SetEmail(ou.Email)
+	if ou.Name != "" {
+		create.SetDisplayName(ou.Name)
+	}
+
+	u, err = create.Save(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	name, err := organizationName(ctx, client, ou.Email)
+	if err != nil {
+		return "", "", err
+	}
+
+	org, err := client.Organization.Create().
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := client.OrgMembership.Create().
+		SetUserID(u.ID).
+		SetOrganizationID(org.ID).
+		SetRole(orgmembership.RoleOWNER).
+		Exec(ctx); err != nil {
+		return "", "", err
+	}
+
+	return u.ID, org.ID, nil
+}
+
+// organizationName returns the name of the organization created for a new user, the email address of the user or,
+// when an organization with that name already exists, the address with a random suffix; the query runs in the
+// transaction of the request so the name is not tried and rolled back on a constraint error
+func organizationName(ctx context.Context, client *ent.Client, email string) (string, error) {
+	name := email
+
+	for range maxOrganizationNameAttempts {
+		exists, err := client.Organization.Query().
+			Where(organization.Name(name)).
+			Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		if !exists {
+			return name, nil
+		}
+
+		name = email + "-" + strings.ToLower(keygen.AlphaNumeric(organizationNameSuffixLength))
+	}
+
+	return "", ErrOrganizationNameTaken
+}
+
+// githubUser returns the function getting the user and its verified primary email address from the GitHub api
+func githubUser(apiURL string) func(context.Context, *http.Client) (*oauthUser, error) {
+	return func(ctx context.Context, client *http.Client) (*oauthUser, error) {
+		var gu struct {
+			Login string `json:"login"`
+			Name  string `json:"name"`
+		}
+
+		if err := getJSON(ctx, client, apiURL+"/user", &gu); err != nil {
+			return nil, err
+		}
+
+		// the email address of the profile may be private or not verified, the primary address is used instead
+		var emails []struct {
+			Email    string `json:"email"`
+			Primary  bool   `json:"primary"`
+			Verified bool   `json:"verified"`
+		}
+
+		if err := getJSON(ctx, client, apiURL+"/user/emails", &emails); err != nil {
+			return nil, err
+		}
+
+		ou := &oauthUser{
+			Name: gu.Name,
+		}
+
+		if ou.Name == "" {
+			ou.Name = gu.Login
+		}
+
+		for _, e := range emails {
+			if e.Primary && e.Verified {
+				ou.Email = e.Email
+			}
+		}
+
+		return ou, nil
+	}
+}
+
+// googleUser returns the function getting the user and its email address, when verified, from the Google api
+func googleUser(apiURL string) func(context.Context, *http.Client) (*oauthUser, error) {
+	return func(ctx context.Context, client *http.Client) (*oauthUser, error) {
+		var gu struct {
+			Email         string `json:"email"`
+			VerifiedEmail bool   `json:"verified_email"`
+			Name          string `json:"name"`
+		}
+
+		if err := getJSON(ctx, client, apiURL+"/oauth2/v2/userinfo", &gu); err != nil {
+			return nil, err
+		}
+
+		ou := &oauthUser{
+			Name: gu.Name,
+		}
+
+		if gu.VerifiedEmail {
+			ou.Email = gu.Email
+		}
+
+		return ou, nil
+	}
+}
+
+// getJSON gets the json response of the url and decodes it into the value
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(echo.HeaderAccept, echo.MIMEApplicationJSON)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s returned status %d", ErrOauthFailed, url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// BindOauthLoginHandler binds the oauth2 login request of the provider to the OpenAPI schema
+func (h *Handler) BindOauthLoginHandler(provider string) *openapi3.Operation {
+	login := openapi3.NewOperation()
+	login.Description = fmt.Sprintf("Starts the login with %s, the user is redirected to %s to authorize the login and is redirected back to the callback endpoint afterwards", provider, provider)
+	login.OperationID = provider + "LoginHandler"
+	login.Tags = []string{"authentication"}
+
+	login.AddResponse(http.StatusFound, openapi3.NewResponse().WithDescription("redirect to the provider"))
+	login.AddResponse(http.StatusBadRequest, badRequest())
+	login.AddResponse(http.StatusInternalServerError, internalServerError())
+
+	return login
+}
+
+// BindOauthCallbackHandler binds the oauth2 callback request of the provider to the OpenAPI schema
+func (h *Handler) BindOauthCallbackHandler(provider string) *openapi3.Operation {
+	callback := openapi3.NewOperation()
+	callback.Description = fmt.Sprintf("Completes the login with %s, the user with the verified email address from %s is created when it does not exist yet and is redirected with an access token and a refresh token set as cookies. Accounts with a password are not linked to the login, their users have to log in with the password", provider, provider)
+	callback.OperationID = provider + "CallbackHandler"
+	callback.Tags = []string{"authentication"}
+
+	callback.AddParameter(openapi3.NewQueryParameter("code").WithSchema(openapi3.NewStringSchema()))
+	callback.AddParameter(openapi3.NewQueryParameter("state").WithSchema(openapi3.NewStringSchema()))
+	callback.AddResponse(http.StatusFound, openapi3.NewResponse().WithDescription("redirect with the tokens set as cookies"))
+	callback.AddResponse(http.StatusBadRequest, badRequest())
+	callback.AddResponse(http.StatusUnauthorized, unauthorized())
+	callback.AddResponse(http.StatusConflict, conflict())
+	callback.AddResponse(http.StatusInternalServerError, internalServerError())
+
+	return callback
+}
@@ -0,0 +1,355 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/sessions"
+	"github.com/datumforge/datum/pkg/testutils"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/entdb"
+	"github.com/datumforge/go-template/pkg/middleware/transaction"
+	"github.com/datumforge/go-template/pkg/oauthmem"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+const (
+	oauthClientID     = "client_id"
+	oauthClientSecret = "client_secret"
+	oauthRedirectURL  = "http://localhost:3001/api/auth/callback/datum"
+)
+
+// oauthFixture holds a handler logging in with github, the provider is served by an in-memory oauth2 server
+type oauthFixture struct {
+	h      *Handler
+	client *ent.Client
+	srv    *oauthmem.Server
+}
+
+func newOauthFixture(t *testing.T) *oauthFixture {
+	t.Helper()
+
+	srv := oauthmem.NewServer(oauthClientID, oauthClientSecret)
+	t.Cleanup(srv.Close)
+
+	client, err := entdb.NewTestClient(context.Background(),
+		testutils.GetTestURI("sqlite://file:"+t.Name()+"?mode=memory&cache=shared&_fk=1", 0), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	cc := sessions.DebugOnlyCookieConfig
+	sessionConfig := sessions.NewSessionConfig(sessions.NewCookieStore[map[string]any](&cc,
+		[]byte("a4f2e1c8b7d6e5f4a3b2c1d0e9f8a7b6"),
+		[]byte("0f1e2d3c4b5a69788796a5b4c3d2e1f0"),
+	))
+	sessionConfig.CookieConfig = &cc
+
+	return &oauthFixture{
+		client: client,
+		srv:    srv,
+		h: &Handler{
+			Logger:        zap.NewNop().Sugar(),
+			TokenManager:  newTokenManager(t),
+			SessionConfig: &sessionConfig,
+			OauthProvider: OauthProviderConfig{
+				RedirectURL: oauthRedirectURL,
+				Github: GithubProviderConfig{
+					ClientID:       oauthClientID,
+					ClientSecret:   oauthClientSecret,
+					ClientEndpoint: "http://localhost:1337",
+				},
+				GithubEndpoints: ProviderEndpoints{
+					AuthURL:  srv.AuthURL(),
+					TokenURL: srv.TokenURL(),
+					APIURL:   srv.URL(),
+				},
+			},
+		},
+	}
+}
+
+// login starts the login with github and authorizes it with the provider, it returns the cookie of the oauth2
+// session and the query of the callback the provider redirects to
+func (f *oauthFixture) login(t *testing.T) (*http.Cookie, url.Values) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, f.h.OauthLoginHandler(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/v1/github/login", nil), rec), githubProvider))
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	// the provider redirects to the callback, the redirect is not followed
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	resp, err := client.Get(rec.Header().Get(echo.HeaderLocation))
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	callback, err := url.Parse(resp.Header.Get(echo.HeaderLocation))
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/github/callback", callback.Path)
+
+	return cookies[0], callback.Query()
+}
+
+// callback sends the callback of the provider with the cookie of the oauth2 session in a transaction
+func (f *oauthFixture) callback(t *testing.T, cookie *http.Cookie, query url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+
+	tx, err := f.client.Tx(context.Background())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/github/callback?"+query.Encode(), nil)
+	req = req.WithContext(transaction.NewContext(req.Context(), tx))
+
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+
+	rec := httptest.NewRecorder()
+
+	if err := f.h.OauthCallbackHandler(echo.New().NewContext(req, rec), githubProvider); err != nil {
+		require.NoError(t, tx.Rollback())
+
+		return rec
+	}
+
+	require.NoError(t, tx.Commit())
+
+	return rec
+}
+
+// sessionCookie returns the cookie of an oauth2 session of github holding the values
+func (f *oauthFixture) sessionCookie(t *testing.T, values map[string]any) *http.Cookie {
+	t.Helper()
+
+	sess := f.h.SessionConfig.SessionManager.New(oauthSessionName)
+	sess.Set(githubProvider, values)
+
+	value, err := f.h.SessionConfig.SessionManager.EncodeCookie(sess)
+	require.NoError(t, err)
+
+	return &http.Cookie{Name: oauthSessionName, Value: value}
+}
+
+func TestOauthCallbackHandlerFlow(t *testing.T) {
+	tests := []struct {
+		name string
+		// callback changes the cookie or the query of the callback, they are sent unchanged when not set
+		callback   func(f *oauthFixture, cookie *http.Cookie, query url.Values) (*http.Cookie, url.Values)
+		wantStatus int
+		wantErr    error
+	}{
+		{
+			name:       "authorized",
+			wantStatus: http.StatusFound,
+		},
+		{
+			name: "state mismatch",
+			callback: func(_ *oauthFixture, cookie *http.Cookie, query url.Values) (*http.Cookie, url.Values) {
+				query.Set("state", "forged")
+
+				return cookie, query
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErr:    ErrInvalidOauthState,
+		},
+		{
+			name: "no state",
+			callback: func(_ *oauthFixture, cookie *http.Cookie, query url.Values) (*http.Cookie, url.Values) {
+				query.Del("state")
+
+				return cookie, query
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErr:    ErrInvalidOauthState,
+		},
+		{
+			name: "no session",
+			callback: func(_ *oauthFixture, _ *http.Cookie, query url.Values) (*http.Cookie, url.Values) {
+				return nil, query
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErr:    ErrInvalidOauthState,
+		},
+		{
+			name: "missing verifier",
+			callback: func(f *oauthFixture, _ *http.Cookie, query url.Values) (*http.Cookie, url.Values) {
+				return f.sessionCookie(t, map[string]any{oauthStateKey: query.Get("state")}), query
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantErr:    ErrOauthFailed,
+		},
+		{
+			name: "login denied by the user",
+			callback: func(_ *oauthFixture, cookie *http.Cookie, query url.Values) (*http.Cookie, url.Values) {
+				return cookie, url.Values{"error": {"access_denied"}, "state": {query.Get("state")}}
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantErr:    ErrOauthFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOauthFixture(t)
+			f.srv.SetUser(oauthmem.User{Login: "octocat", Name: "Octo Cat", Email: "octocat@github.com", Verified: true})
+
+			cookie, query := f.login(t)
+			if tt.callback != nil {
+				cookie, query = tt.callback(f, cookie, query)
+			}
+
+			rec := f.callback(t, cookie, query)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantErr != nil {
+				assert.Contains(t, rec.Body.String(), tt.wantErr.Error())
+
+				return
+			}
+
+			assert.Equal(t, oauthRedirectURL, rec.Header().Get(echo.HeaderLocation))
+		})
+	}
+}
+
+func TestOauthCallbackHandlerUpsert(t *testing.T) {
+	tests := []struct {
+		name string
+		// existing creates the user with the email address before the login
+		existing     func(ctx context.Context, client *ent.Client) *ent.User
+		providerUser oauthmem.User
+		wantStatus   int
+		wantErr      error
+		wantName     string
+		// wantOrgSuffix is set when the email address is already the name of another organization
+		wantOrgSuffix bool
+	}{
+		{
+			name:         "new user",
+			providerUser: oauthmem.User{Login: "octocat", Name: "Octo Cat", Email: "octocat@github.com", Verified: true},
+			wantStatus:   http.StatusFound,
+			wantName:     "Octo Cat",
+		},
+		{
+			name:         "new user without a name",
+			providerUser: oauthmem.User{Login: "octocat", Email: "octocat@github.com", Verified: true},
+			wantStatus:   http.StatusFound,
+			wantName:     "octocat",
+		},
+		{
+			name: "user that logged in with a provider before",
+			existing: func(ctx context.Context, client *ent.Client) *ent.User {
+				u := client.User.Create().SetEmail("octocat@github.com").SaveX(ctx)
+				org := client.Organization.Create().SetName("octocat@github.com").SaveX(ctx)
+				client.OrgMembership.Create().SetUserID(u.ID).SetOrganizationID(org.ID).SetRole(orgmembership.RoleOWNER).SaveX(ctx)
+
+				return u
+			},
+			providerUser: oauthmem.User{Login: "octocat", Name: "Octo Cat", Email: "octocat@github.com", Verified: true},
+			wantStatus:   http.StatusFound,
+			wantName:     "Octo Cat",
+		},
+		{
+			name: "organization with the email address of the new user",
+			existing: func(ctx context.Context, client *ent.Client) *ent.User {
+				client.Organization.Create().SetName("octocat@github.com").SaveX(ctx)
+
+				return nil
+			},
+			providerUser:  oauthmem.User{Login: "octocat", Name: "Octo Cat", Email: "octocat@github.com", Verified: true},
+			wantStatus:    http.StatusFound,
+			wantName:      "Octo Cat",
+			wantOrgSuffix: true,
+		},
+		{
+			name: "user with a password",
+			existing: func(ctx context.Context, client *ent.Client) *ent.User {
+				return client.User.Create().SetEmail("octocat@github.com").SetDisplayName("Octo").SetPassword("not-the-octocat").SaveX(ctx)
+			},
+			providerUser: oauthmem.User{Login: "octocat", Name: "Octo Cat", Email: "octocat@github.com", Verified: true},
+			wantStatus:   http.StatusConflict,
+			wantErr:      ErrPasswordAccount,
+			wantName:     "Octo",
+		},
+		{
+			name:         "email address is not verified",
+			providerUser: oauthmem.User{Login: "octocat", Name: "Octo Cat", Email: "octocat@github.com"},
+			wantStatus:   http.StatusBadRequest,
+			wantErr:      ErrNoVerifiedEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOauthFixture(t)
+			ctx := interceptors.SkipTenant(context.Background())
+
+			var existing *ent.User
+			if tt.existing != nil {
+				existing = tt.existing(ctx, f.client)
+			}
+
+			f.srv.SetUser(tt.providerUser)
+
+			cookie, query := f.login(t)
+
+			rec := f.callback(t, cookie, query)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantErr != nil {
+				assert.Contains(t, rec.Body.String(), tt.wantErr.Error())
+			}
+
+			users := f.client.User.Query().Where(user.Email(tt.providerUser.Email)).AllX(ctx)
+
+			if tt.wantName == "" {
+				assert.Empty(t, users)
+
+				return
+			}
+
+			require.Len(t, users, 1)
+			assert.Equal(t, tt.wantName, users[0].DisplayName)
+
+			if existing != nil {
+				assert.Equal(t, existing.ID, users[0].ID)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			membership := users[0].QueryMemberships().Where(orgmembership.RoleEQ(orgmembership.RoleOWNER)).OnlyX(ctx)
+			org := membership.QueryOrganization().OnlyX(ctx)
+
+			if tt.wantOrgSuffix {
+				assert.Regexp(t, `^octocat@github\.com-[a-z0-9]{6}$`, org.Name)
+			} else {
+				assert.Equal(t, tt.providerUser.Email, org.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/datumforge/datum/pkg/httpsling"
+)
+
+// AddResponse is used to add a response definition to the OpenAPI schema
+func (h *Handler) AddResponse(name string, description string, body interface{}, op *openapi3.Operation, status int) {
+	response := openapi3.NewResponse().
+		WithDescription(description).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/schemas/" + name}))
+	op.AddResponse(status, response)
+
+	response.Content.Get(httpsling.ContentTypeJSON).Examples = make(map[string]*openapi3.ExampleRef)
+	response.Content.Get(httpsling.ContentTypeJSON).Examples["success"] = &openapi3.ExampleRef{Value: openapi3.NewExample(body)}
+}
+
+// AddRequestBody is used to add a request body definition to the OpenAPI schema
+func (h *Handler) AddRequestBody(name string, body interface{}, op *openapi3.Operation) {
+	request := openapi3.NewRequestBody().
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/schemas/" + name}))
+	op.RequestBody = &openapi3.RequestBodyRef{Value: request}
+
+	request.Content.Get(httpsling.ContentTypeJSON).Examples = make(map[string]*openapi3.ExampleRef)
+	request.Content.Get(httpsling.ContentTypeJSON).Examples["success"] = &openapi3.ExampleRef{Value: openapi3.NewExample(body)}
+}
+
+// badRequest is a wrapper for openAPI bad request response
+func badRequest() *openapi3.Response {
+	return openapi3.NewResponse().
+		WithDescription("Bad Request").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/responses/BadRequest"}))
+}
+
+// unauthorized is a wrapper for openAPI unauthorized response
+func unauthorized() *openapi3.Response {
+	return openapi3.NewResponse().
+		WithDescription("Unauthorized").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/responses/Unauthorized"}))
+}
+
+// internalServerError is a wrapper for openAPI internal server error response
+func internalServerError() *openapi3.Response {
+	return openapi3.NewResponse().
+		WithDescription("Internal Server Error").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/responses/InternalServerError"}))
+}
+
+// conflict is a wrapper for openAPI conflict response
+func conflict() *openapi3.Response {
+	return openapi3.NewResponse().
+		WithDescription("Conflict").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/responses/Conflict"}))
+}
+
+// notImplemented is a wrapper for openAPI not implemented response
+func notImplemented() *openapi3.Response {
+	return openapi3.NewResponse().
+		WithDescription("Not Implemented").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(&openapi3.SchemaRef{Ref: "#/components/responses/NotImplemented"}))
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/pkg/middleware/transaction"
+	"github.com/datumforge/go-template/pkg/revocation"
+)
+
+// RefreshHandler exchanges a refresh token for a new access and refresh token, the refresh token is rotated:
+// it is revoked, together with the access token issued with it, so it can only be used once. The endpoint needs
+// the revocation list, it is not implemented when redis is disabled
+func (h *Handler) RefreshHandler(ctx echo.Context) error {
+	var in models.RefreshRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	// without a revocation list the refresh tokens could not be rotated and could be used until they expire
+	if h.TokenRevocations == nil {
+		return h.NotImplemented(ctx, ErrRevocationNotConfigured)
+	}
+
+	claims, err := h.TokenManager.Verify(in.RefreshToken)
+	if err != nil {
+		return h.Unauthorized(ctx, ErrInvalidRefreshToken)
+	}
+
+	// access tokens can not be used to refresh
+	if !slices.Contains(claims.Audience, h.TokenManager.RefreshAudience()) {
+		return h.Unauthorized(ctx, ErrInvalidRefreshToken)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// revoking the token fails when it was already used, so only one of concurrent refreshes succeeds
+	revoked, err := h.TokenRevocations.Revoke(reqCtx, claims.ID, claims.ExpiresAt.Time)
+	if err != nil && !errors.Is(err, revocation.ErrExpired) {
+		h.Logger.Errorw("error revoking refresh token", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if !revoked {
+		return h.Unauthorized(ctx, ErrInvalidRefreshToken)
+	}
+
+	// the user has to still be a member of the organization of the token
+	reqCtx = interceptors.SkipTenant(reqCtx)
+
+	member, err := transaction.FromContext(reqCtx).Client().OrgMembership.Query().
+		Where(
+			orgmembership.UserID(claims.Subject),
+			orgmembership.OrganizationID(claims.OrgID),
+			orgmembership.HasUserWith(user.DeletedAtIsNil()),
+		).
+		Exist(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("error getting membership of user", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if !member {
+		return h.Unauthorized(ctx, ErrInvalidRefreshToken)
+	}
+
+	accessToken, refreshToken, err := h.TokenManager.CreateTokenPair(newClaims(claims.Subject, claims.OrgID))
+	if err != nil {
+		h.Logger.Errorw("error creating token pair", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	out := &models.RefreshReply{
+		Reply:   rout.Reply{Success: true},
+		Message: "success",
+		AuthData: models.AuthData{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			TokenType:    tokenType,
+		},
+	}
+
+	return h.Success(ctx, out)
+}
+
+// BindRefreshHandler binds the refresh request to the OpenAPI schema
+func (h *Handler) BindRefreshHandler() *openapi3.Operation {
+	refresh := openapi3.NewOperation()
+	refresh.Description = "Refresh returns a new access token and refresh token in exchange for a refresh token, so the user stays authenticated without logging in again once the access token expires. The refresh token can only be used once, it is revoked together with the access token issued with it. Refreshing needs the revocation list of the server, kept in redis, and is not implemented when redis is disabled"
+	refresh.OperationID = "RefreshHandler"
+	refresh.Tags = []string{"authentication"}
+
+	h.AddRequestBody("RefreshRequest", models.ExampleRefreshRequest, refresh)
+	h.AddResponse("RefreshReply", "success", models.ExampleRefreshSuccessResponse, refresh, http.StatusOK)
+	refresh.AddResponse(http.StatusInternalServerError, internalServerError())
+	refresh.AddResponse(http.StatusBadRequest, badRequest())
+	refresh.AddResponse(http.StatusUnauthorized, unauthorized())
+	refresh.AddResponse(http.StatusNotImplemented, notImplemented())
+
+	return refresh
+}
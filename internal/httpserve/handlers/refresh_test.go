@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRefreshHandlerWithoutRevocations(t *testing.T) {
+	tm := newTokenManager(t)
+
+	_, refreshToken, err := tm.CreateTokenPair(newClaims("user_01", "org_01"))
+	require.NoError(t, err)
+
+	h := &Handler{
+		Logger:       zap.NewNop().Sugar(),
+		TokenManager: tm,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/refresh", strings.NewReader(`{"refresh_token": "`+refreshToken+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+
+	_ = h.RefreshHandler(echo.New().NewContext(req, rec))
+
+	// the refresh token can not be rotated, so it is not exchanged
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrRevocationNotConfigured.Error())
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// wellKnownCacheControl is the Cache-Control header of the well-known documents, the keys only change
+// when they are rotated so other services can keep them for an hour before fetching them again
+const wellKnownCacheControl = "public, max-age=3600"
+
+const (
+	// jwksPath is the path of the JSON Web Key Set
+	jwksPath = "/.well-known/jwks.json"
+	// loginPath is the path of the login endpoint, the authorization endpoint of the discovery document
+	loginPath = "/v1/login"
+	// refreshPath is the path of the refresh endpoint, the token endpoint of the discovery document
+	refreshPath = "/v1/refresh"
+)
+
+// noCacheHeaders are the headers set on every response by the cache control middleware of the server
+// that prevent the well-known documents from being cached
+var noCacheHeaders = []string{"Expires", "Pragma", "X-Accel-Expires"}
+
+// OpenIDConfiguration is the OpenID Connect discovery document describing the tokens issued by the server, it has
+// the fields required by the discovery specification; the tokens are returned in the response of the endpoints
+// and signed with RS256
+type OpenIDConfiguration struct {
+	// Issuer is the issuer of the tokens, the `iss` claim
+	Issuer string `json:"issuer"`
+	// AuthorizationEndpoint is the url where users authenticate to get tokens, the login endpoint
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	// TokenEndpoint is the url where refresh tokens are exchanged for new tokens, the refresh endpoint
+	TokenEndpoint string `json:"token_endpoint"`
+	// JWKSURI is the url of the JSON Web Key Set used to verify the tokens
+	JWKSURI string `json:"jwks_uri"`
+	// ResponseTypesSupported are the OAuth 2.0 response types supported by the server
+	ResponseTypesSupported []string `json:"response_types_supported"`
+	// GrantTypesSupported are the OAuth 2.0 grant types supported by the token endpoint
+	GrantTypesSupported []string `json:"grant_types_supported"`
+	// SubjectTypesSupported are the subject identifier types supported by the server
+	SubjectTypesSupported []string `json:"subject_types_supported"`
+	// IDTokenSigningAlgValuesSupported are the algorithms used to sign the tokens
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	// ClaimsSupported are the claims included in the tokens
+	ClaimsSupported []string `json:"claims_supported"`
+}
+
+// exampleOpenIDConfiguration is the example of the discovery document in the OpenAPI schema
+var exampleOpenIDConfiguration = OpenIDConfiguration{
+	Issuer:                           "https://auth.datum.net",
+	AuthorizationEndpoint:            "https://api.datum.net" + loginPath,
+	TokenEndpoint:                    "https://api.datum.net" + refreshPath,
+	JWKSURI:                          "https://api.datum.net/.well-known/jwks.json",
+	ResponseTypesSupported:           []string{"token"},
+	GrantTypesSupported:              []string{"refresh_token"},
+	SubjectTypesSupported:            []string{"public"},
+	IDTokenSigningAlgValuesSupported: []string{"RS256"},
+	ClaimsSupported:                  []string{"iss", "sub", "aud", "exp", "iat", "nbf", "jti", "user_id", "org"},
+}
+
+// JWKSWellKnownHandler returns the public keys of the JSON Web Key Set used to verify the tokens issued by the server
+func (h *Handler) JWKSWellKnownHandler(ctx echo.Context) error {
+	setWellKnownCacheHeaders(ctx)
+
+	return ctx.JSON(http.StatusOK, h.JWTKeys)
+}
+
+// OpenIDConfigurationHandler returns the OpenID Connect discovery document of the server, the endpoints are on
+// the host of the JWKS endpoint or, when it is not an absolute url, on the host of the request
+func (h *Handler) OpenIDConfigurationHandler(ctx echo.Context) error {
+	jwks, err := url.Parse(h.TokenConfig.JWKSEndpoint)
+	if err != nil || jwks.Path == "" {
+		jwks = &url.URL{Path: jwksPath}
+	}
+
+	base := jwks
+	if !base.IsAbs() {
+		base = &url.URL{Scheme: ctx.Scheme(), Host: ctx.Request().Host}
+	}
+
+	out := exampleOpenIDConfiguration
+	out.Issuer = h.TokenConfig.Issuer
+	out.AuthorizationEndpoint = base.ResolveReference(&url.URL{Path: loginPath}).String()
+	out.TokenEndpoint = base.ResolveReference(&url.URL{Path: refreshPath}).String()
+	out.JWKSURI = base.ResolveReference(jwks).String()
+
+	setWellKnownCacheHeaders(ctx)
+
+	return ctx.JSON(http.StatusOK, out)
+}
+
+// setWellKnownCacheHeaders replaces the no-cache headers of the cache control middleware so the well-known
+// documents can be cached by other services
+func setWellKnownCacheHeaders(ctx echo.Context) {
+	header := ctx.Response().Header()
+
+	for _, h := range noCacheHeaders {
+		header.Del(h)
+	}
+
+	header.Set(echo.HeaderCacheControl, wellKnownCacheControl)
+}
+
+// BindJWKSWellKnownHandler binds the JWKS request to the OpenAPI schema
+func (h *Handler) BindJWKSWellKnownHandler() *openapi3.Operation {
+	jwks := openapi3.NewOperation()
+	jwks.Description = "JWKS returns the JSON Web Key Set with the public keys used to verify the access and refresh tokens issued by the server, the keys are matched to the tokens by the `kid` header"
+	jwks.OperationID = "JWKSWellKnownHandler"
+	jwks.Tags = []string{"discovery"}
+
+	keys := openapi3.NewObjectSchema().
+		WithProperty("keys", openapi3.NewArraySchema().WithItems(openapi3.NewObjectSchema()))
+
+	jwks.AddResponse(http.StatusOK, openapi3.NewResponse().
+		WithDescription("success").
+		WithJSONSchema(keys))
+
+	return jwks
+}
+
+// BindOpenIDConfigurationHandler binds the OpenID Connect discovery request to the OpenAPI schema
+func (h *Handler) BindOpenIDConfigurationHandler() *openapi3.Operation {
+	config := openapi3.NewOperation()
+	config.Description = "OpenIDConfiguration returns the OpenID Connect discovery document with the issuer of the tokens, the login and refresh endpoints issuing them and the location of the JSON Web Key Set, so other services can verify the tokens issued by the server"
+	config.OperationID = "OpenIDConfigurationHandler"
+	config.Tags = []string{"discovery"}
+
+	h.AddResponse("OpenIDConfiguration", "success", exampleOpenIDConfiguration, config, http.StatusOK)
+
+	return config
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datumforge/datum/pkg/middleware/cachecontrol"
+	"github.com/datumforge/datum/pkg/tokens"
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWellKnownHandlers(t *testing.T) {
+	tm := newTokenManager(t)
+
+	keys, err := tm.Keys()
+	require.NoError(t, err)
+
+	h := &Handler{
+		JWTKeys: keys,
+		TokenConfig: tokens.Config{
+			Issuer:       "https://auth.datum.net",
+			JWKSEndpoint: "https://api.datum.net/.well-known/jwks.json",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		handler  echo.HandlerFunc
+		wantKeys []string
+	}{
+		{
+			name:     "jwks",
+			path:     "/.well-known/jwks.json",
+			handler:  h.JWKSWellKnownHandler,
+			wantKeys: []string{"keys"},
+		},
+		{
+			name:     "openid configuration",
+			path:     "/.well-known/openid-configuration",
+			handler:  h.OpenIDConfigurationHandler,
+			wantKeys: []string{"issuer", "authorization_endpoint", "token_endpoint", "jwks_uri", "response_types_supported", "subject_types_supported", "id_token_signing_alg_values_supported"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the handlers run after the cache control middleware of the server
+			e := echo.New()
+			e.Use(cachecontrol.New())
+			e.GET(tt.path, tt.handler)
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			assert.Equal(t, wellKnownCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+
+			for _, header := range []string{"Expires", "Pragma", "X-Accel-Expires"} {
+				assert.Empty(t, rec.Header().Values(header), header)
+			}
+
+			out := map[string]any{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+
+			for _, key := range tt.wantKeys {
+				assert.NotEmpty(t, out[key], key)
+			}
+		})
+	}
+}
+
+func TestOpenIDConfigurationEndpoints(t *testing.T) {
+	tests := []struct {
+		name         string
+		jwksEndpoint string
+		wantBase     string
+		wantJWKS     string
+	}{
+		{
+			name:         "endpoints on the host of the jwks endpoint",
+			jwksEndpoint: "https://api.datum.net/.well-known/jwks.json",
+			wantBase:     "https://api.datum.net",
+			wantJWKS:     "https://api.datum.net/.well-known/jwks.json",
+		},
+		{
+			name:         "relative jwks endpoint",
+			jwksEndpoint: "/keys.json",
+			wantBase:     "http://example.com",
+			wantJWKS:     "http://example.com/keys.json",
+		},
+		{
+			name:     "no jwks endpoint",
+			wantBase: "http://example.com",
+			wantJWKS: "http://example.com/.well-known/jwks.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				TokenConfig: tokens.Config{
+					Issuer:       "https://auth.datum.net",
+					JWKSEndpoint: tt.jwksEndpoint,
+				},
+			}
+
+			rec := httptest.NewRecorder()
+			require.NoError(t, h.OpenIDConfigurationHandler(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil), rec)))
+
+			var out OpenIDConfiguration
+
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+			assert.Equal(t, "https://auth.datum.net", out.Issuer)
+			assert.Equal(t, tt.wantBase+"/v1/login", out.AuthorizationEndpoint)
+			assert.Equal(t, tt.wantBase+"/v1/refresh", out.TokenEndpoint)
+			assert.Equal(t, tt.wantJWKS, out.JWKSURI)
+			assert.Equal(t, []string{"RS256"}, out.IDTokenSigningAlgValuesSupported)
+		})
+	}
+}
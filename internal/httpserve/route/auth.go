@@ -0,0 +1,82 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+)
+
+// registerLoginHandler registers the login handler that issues the access and refresh tokens
+func registerLoginHandler(router *Router) (err error) {
+	path := "/login"
+	method := http.MethodPost
+	name := "Login"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: restrictedEndpointsMW,
+		Handler: func(c echo.Context) error {
+			return router.Handler.LoginHandler(c)
+		},
+	}
+
+	loginOperation := router.Handler.BindLoginHandler()
+
+	if err := router.Addv1Route(path, method, loginOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerRefreshHandler registers the refresh handler that rotates the refresh token
+func registerRefreshHandler(router *Router) (err error) {
+	path := "/refresh"
+	method := http.MethodPost
+	name := "Refresh"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: restrictedEndpointsMW,
+		Handler: func(c echo.Context) error {
+			return router.Handler.RefreshHandler(c)
+		},
+	}
+
+	refreshOperation := router.Handler.BindRefreshHandler()
+
+	if err := router.Addv1Route(path, method, refreshOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerLogoutHandler registers the logout handler that revokes the tokens
+func registerLogoutHandler(router *Router) (err error) {
+	path := "/logout"
+	method := http.MethodPost
+	name := "Logout"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: restrictedEndpointsMW,
+		Handler: func(c echo.Context) error {
+			return router.Handler.LogoutHandler(c)
+		},
+	}
+
+	logoutOperation := router.Handler.BindLogoutHandler()
+
+	if err := router.Addv1Route(path, method, logoutOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+)
+
+// registerOauthHandlers registers the login and callback handlers of each supported oauth2 provider
+func registerOauthHandlers(router *Router) (err error) {
+	for _, provider := range router.Handler.SupportedProviders {
+		if err := router.Handler.ValidateOauthProvider(provider); err != nil {
+			return err
+		}
+
+		if err := registerOauthLoginHandler(router, provider); err != nil {
+			return err
+		}
+
+		if err := registerOauthCallbackHandler(router, provider); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerOauthLoginHandler registers the handler redirecting the user to the provider to log in
+func registerOauthLoginHandler(router *Router, provider string) (err error) {
+	path := "/" + provider + "/login"
+	method := http.MethodGet
+	name := provider + "Login"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: restrictedEndpointsMW,
+		Handler: func(c echo.Context) error {
+			return router.Handler.OauthLoginHandler(c, provider)
+		},
+	}
+
+	loginOperation := router.Handler.BindOauthLoginHandler(provider)
+
+	if err := router.Addv1Route(path, method, loginOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerOauthCallbackHandler registers the handler the provider redirects the user back to
+func registerOauthCallbackHandler(router *Router, provider string) (err error) {
+	path := "/" + provider + "/callback"
+	method := http.MethodGet
+	name := provider + "Callback"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: restrictedEndpointsMW,
+		Handler: func(c echo.Context) error {
+			return router.Handler.OauthCallbackHandler(c, provider)
+		},
+	}
+
+	callbackOperation := router.Handler.BindOauthCallbackHandler(provider)
+
+	if err := router.Addv1Route(path, method, callbackOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
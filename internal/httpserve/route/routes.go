@@ -118,6 +118,12 @@ func RegisterRoutes(router *Router) error {
 		registerReadinessHandler,
 		registerLivenessHandler,
 		registerMetricsHandler,
+		registerJwksWellKnownHandler,
+		registerOpenIDConfigurationHandler,
+		registerLoginHandler,
+		registerRefreshHandler,
+		registerLogoutHandler,
+		registerOauthHandlers,
 	}
 
 	for _, route := range routeHandlers {
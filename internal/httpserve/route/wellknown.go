@@ -0,0 +1,57 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+)
+
+// registerJwksWellKnownHandler supplies the JWKS endpoint
+func registerJwksWellKnownHandler(router *Router) (err error) {
+	path := "/.well-known/jwks.json"
+	method := http.MethodGet
+	name := "JWKS"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.JWKSWellKnownHandler(c)
+		},
+	}
+
+	jwksOperation := router.Handler.BindJWKSWellKnownHandler()
+
+	if err := router.AddUnversionedRoute(path, method, jwksOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerOpenIDConfigurationHandler supplies the OpenID Connect discovery document
+func registerOpenIDConfigurationHandler(router *Router) (err error) {
+	path := "/.well-known/openid-configuration"
+	method := http.MethodGet
+	name := "OpenIDConfiguration"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.OpenIDConfigurationHandler(c)
+		},
+	}
+
+	configOperation := router.Handler.BindOpenIDConfigurationHandler()
+
+	if err := router.AddUnversionedRoute(path, method, configOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3gen"
 
+	"github.com/datumforge/datum/pkg/models"
 	"github.com/datumforge/datum/pkg/rout"
+
+	"github.com/datumforge/go-template/internal/httpserve/handlers"
 )
 
 // NewOpenAPISpec creates a new OpenAPI 3.1.0 specification based on the configured go interfaces and the operation types appended within the individual handlers
@@ -55,6 +58,13 @@ func NewOpenAPISpec() (*openapi3.T, error) {
 		WithContent(openapi3.NewContentWithJSONSchemaRef(errorResponse))
 	responses["Conflict"] = &openapi3.ResponseRef{Value: conflict}
 
+	notImplemented := openapi3.NewResponse().
+		WithDescription("Not Implemented").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(errorResponse))
+	responses["NotImplemented"] = &openapi3.ResponseRef{Value: notImplemented}
+
+	securityschemes["bearerAuth"] = &openapi3.SecuritySchemeRef{Value: openapi3.NewJWTSecurityScheme()}
+
 	return &openapi3.T{
 		OpenAPI: "3.1.0",
 		Info: &openapi3.Info{
@@ -103,13 +113,28 @@ func NewOpenAPISpec() (*openapi3.T, error) {
 				Name:        "graphql",
 				Description: "GraphQL query endpoints",
 			},
+			&openapi3.Tag{
+				Name:        "discovery",
+				Description: "Keys and metadata to verify the tokens issued by the server",
+			},
+			&openapi3.Tag{
+				Name:        "authentication",
+				Description: "Issue, refresh and revoke access tokens",
+			},
 		},
 	}, nil
 }
 
 // openAPISchemas is a mapping of types to auto generate schemas for - these specifically live under the OAS "schema" type so that we can simply make schemaRef's to them and not have to define them all individually in the OAS paths
 var openAPISchemas = map[string]any{
-	"ErrorResponse": &rout.StatusError{},
+	"ErrorResponse":       &rout.StatusError{},
+	"OpenIDConfiguration": &handlers.OpenIDConfiguration{},
+	"LoginRequest":        &models.LoginRequest{},
+	"LoginReply":          &models.LoginReply{},
+	"RefreshRequest":      &models.RefreshRequest{},
+	"RefreshReply":        &models.RefreshReply{},
+	"LogoutRequest":       &handlers.LogoutRequest{},
+	"LogoutReply":         &handlers.LogoutReply{},
 }
 
 // OAuth2 is a struct that represents an OAuth2 security scheme
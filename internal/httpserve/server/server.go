@@ -7,7 +7,6 @@ import (
 	"go.uber.org/zap"
 
 	echodebug "github.com/datumforge/datum/pkg/middleware/debug"
-	"github.com/datumforge/datum/pkg/tokens"
 
 	"github.com/datumforge/go-template/internal/httpserve/config"
 	"github.com/datumforge/go-template/internal/httpserve/route"
@@ -79,20 +78,6 @@ func (s *Server) StartEchoServer(ctx context.Context) error {
 		srv.Echo.Use(m)
 	}
 
-	// Setup token manager
-	tm, err := tokens.New(s.config.Settings.Auth.Token)
-	if err != nil {
-		return err
-	}
-
-	keys, err := tm.Keys()
-	if err != nil {
-		return err
-	}
-
-	// pass to the REST handlers
-	s.config.Handler.JWTKeys = keys
-
 	srv.Handler = &s.config.Handler
 
 	// Add base routes to the server
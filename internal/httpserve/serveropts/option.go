@@ -1,19 +1,31 @@
 package serveropts
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+
 	echoprometheus "github.com/datumforge/echo-prometheus/v5"
 	echo "github.com/datumforge/echox"
 	"github.com/datumforge/echox/middleware"
 	"github.com/datumforge/echozap"
 	"github.com/datumforge/entx"
+	"github.com/datumforge/fgax"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
 	"github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/user"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
 	"github.com/datumforge/go-template/internal/graphapi"
 	"github.com/datumforge/go-template/internal/httpserve/config"
 	"github.com/datumforge/go-template/internal/httpserve/server"
+	"github.com/datumforge/go-template/pkg/middleware/bearer"
+	"github.com/datumforge/go-template/pkg/middleware/transaction"
+	"github.com/datumforge/go-template/pkg/revocation"
 
+	"github.com/datumforge/datum/pkg/auth"
 	"github.com/datumforge/datum/pkg/cache"
 	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/middleware/cachecontrol"
@@ -23,6 +35,17 @@ import (
 	"github.com/datumforge/datum/pkg/middleware/ratelimit"
 	"github.com/datumforge/datum/pkg/middleware/redirect"
 	"github.com/datumforge/datum/pkg/sessions"
+	"github.com/datumforge/datum/pkg/tokens"
+)
+
+// generatedKeySize is the size of the signing key generated when no keys are configured
+const generatedKeySize = 4096
+
+const (
+	// devUserEmail is the email address of the user the graph requests run as when authentication is disabled
+	devUserEmail = "dev@localhost"
+	// devUserName is the name of the development user and its organization
+	devUserName = "development"
 )
 
 type ServerOption interface {
@@ -78,7 +101,7 @@ func WithHTTPS() ServerOption {
 }
 
 // WithReadyChecks adds readiness checks to the server
-func WithReadyChecks(c *entx.EntClientConfig, r *redis.Client) ServerOption {
+func WithReadyChecks(c *entx.EntClientConfig, r *redis.Client, f *fgax.Client) ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
 		// Always add a check to the primary db connection
 		s.Config.Handler.AddReadinessCheck("db_primary", entx.Healthcheck(c.GetPrimaryDB()))
@@ -92,6 +115,11 @@ func WithReadyChecks(c *entx.EntClientConfig, r *redis.Client) ServerOption {
 		if s.Config.Settings.Redis.Enabled {
 			s.Config.Handler.AddReadinessCheck("redis", cache.Healthcheck(r))
 		}
+
+		// Check the connection to openFGA, if enabled
+		if s.Config.Settings.Authz.Enabled {
+			s.Config.Handler.AddReadinessCheck("fga", fgax.Healthcheck(*f))
+		}
 	})
 }
 
@@ -100,7 +128,10 @@ func WithGraphRoute(srv *server.Server, c *generated.Client) ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
 		// Setup Graph API Handlers
 		r := graphapi.NewResolver(c).
-			WithLogger(s.Config.Logger.Named("resolvers"))
+			WithLogger(s.Config.Logger.Named("resolvers")).
+			WithDebug(s.Config.Settings.Server.Debug).
+			WithSettings(s.Config.Settings.Server.GraphQL).
+			WithRedisClient(s.Config.Handler.RedisClient)
 
 		handler := r.Handler(s.Config.Settings.Server.Dev)
 
@@ -129,9 +160,10 @@ func WithMiddleware() ServerOption {
 			echocontext.EchoContextToContextMiddleware(),                                             // adds echo context to parent
 			cors.New(s.Config.Settings.Server.CORS.AllowOrigins),                                     // add cors middleware
 			mime.NewWithConfig(mime.Config{DefaultContentType: echo.MIMEApplicationJSONCharsetUTF8}), // add mime middleware
-			cachecontrol.New(),                        // add cache control middleware
-			middleware.Secure(),                       // add XSS middleware
-			redirect.NewWithConfig(redirect.Config{}), // add redirect middleware
+			transaction.KeepIfMatch(),                                                                // keep the If-Match header removed by the cache control middleware
+			cachecontrol.New(),                                                                       // add cache control middleware
+			middleware.Secure(),                                                                      // add XSS middleware
+			redirect.NewWithConfig(redirect.Config{}),                                                // add redirect middleware
 		)
 	})
 }
@@ -145,6 +177,161 @@ func WithRateLimiter() ServerOption {
 	})
 }
 
+// WithAuth sets up the token manager and the list of revoked tokens, which is only kept when redis is enabled, and,
+// when authentication is enabled, adds the bearer authentication middleware to the graph and authenticated REST routes; when it
+// is disabled the graph requests run as the development user. It has to be added before the session manager so the user id
+// is known when the session is loaded
+func WithAuth(rc *redis.Client) ServerOption {
+	return newApplyFunc(func(s *ServerOptions) {
+		tm, err := newTokenManager(s.Config.Settings.Auth.Token)
+		if err != nil {
+			s.Config.Logger.Fatalw("error creating token manager", "error", err)
+		}
+
+		keys, err := tm.Keys()
+		if err != nil {
+			s.Config.Logger.Fatalw("error getting token manager keys", "error", err)
+		}
+
+		bearerOpts := bearer.Options{
+			Validator:        tokens.NewJWKSValidator(keys, s.Config.Settings.Auth.Token.Audience, s.Config.Settings.Auth.Token.Issuer),
+			PublicOperations: s.Config.Settings.Auth.PublicOperations,
+		}
+
+		// the revoked tokens are kept in redis, without it tokens can not be revoked and stay valid until they expire;
+		// the logout and refresh endpoints are then not implemented
+		if s.Config.Settings.Redis.Enabled {
+			revocations := revocation.New(rc)
+
+			s.Config.Handler.TokenRevocations = revocations
+			bearerOpts.Revocations = revocations
+		}
+
+		// pass to the REST handlers
+		s.Config.Handler.TokenManager = tm
+		s.Config.Handler.JWTKeys = keys
+		s.Config.Handler.TokenConfig = s.Config.Settings.Auth.Token
+		s.Config.Handler.OauthProvider = s.Config.Settings.Auth.Providers
+		s.Config.Handler.SupportedProviders = s.Config.Settings.Auth.SupportedProviders
+
+		if !s.Config.Settings.Auth.Enabled {
+			s.Config.Logger.Warnw("authentication is disabled, graph routes are reachable anonymously and run as the development user", "email", devUserEmail)
+
+			userID, orgID, err := devUser(context.Background(), s.Config.Handler.DBClient)
+			if err != nil {
+				s.Config.Logger.Fatalw("error creating the development user", "error", err)
+			}
+
+			// without a user and organization in the context every graph operation would be denied
+			s.Config.GraphMiddleware = append(s.Config.GraphMiddleware, devAuth(userID, orgID))
+
+			return
+		}
+
+		authMiddleware := bearer.Authenticate(bearerOpts)
+
+		s.Config.Handler.AuthMiddleware = append(s.Config.Handler.AuthMiddleware, authMiddleware)
+		s.Config.GraphMiddleware = append(s.Config.GraphMiddleware, authMiddleware)
+	})
+}
+
+// devAuth runs the request as the development user in its organization, it is only used for the graph
+// routes when authentication is disabled so the requests are still scoped to an organization
+func devAuth(userID, orgID string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			auth.AddAuthenticatedUserContext(c, &auth.AuthenticatedUser{
+				SubjectID:          userID,
+				OrganizationID:     orgID,
+				OrganizationIDs:    []string{orgID},
+				AuthenticationType: auth.JWTAuthentication,
+			})
+
+			return next(c)
+		}
+	}
+}
+
+// devUser returns the ids of the development user and the organization it owns, they are created
+// the first time the server starts with authentication disabled
+func devUser(ctx context.Context, client *generated.Client) (userID, orgID string, err error) {
+	// the user is looked up before its organization is known
+	ctx = interceptors.SkipTenant(ctx)
+
+	om, err := client.OrgMembership.Query().
+		Where(
+			orgmembership.HasUserWith(user.Email(devUserEmail)),
+			orgmembership.RoleEQ(orgmembership.RoleOWNER),
+		).
+		First(ctx)
+	if err == nil {
+		return om.UserID, om.OrganizationID, nil
+	}
+
+	if !generated.IsNotFound(err) {
+		return "", "", err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	userID, orgID, err = createDevUser(ctx, tx.Client())
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return "", "", rerr
+		}
+
+		return "", "", err
+	}
+
+	return userID, orgID, tx.Commit()
+}
+
+// createDevUser creates the development user and the organization it owns
+func createDevUser(ctx context.Context, client *generated.Client) (userID, orgID string, err error) {
+	u, err := client.User.Create().
+		SetEmail(devUserEmail).
+		SetDisplayName(devUserName).
+		Save(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	org, err := client.Organization.Create().
+		SetName(devUserName).
+		Save(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := client.OrgMembership.Create().
+		SetUserID(u.ID).
+		SetOrganizationID(org.ID).
+		SetRole(orgmembership.RoleOWNER).
+		Exec(ctx); err != nil {
+		return "", "", err
+	}
+
+	return u.ID, org.ID, nil
+}
+
+// newTokenManager creates the token manager with the configured keys, a signing key is generated
+// when no keys are configured and generating keys is enabled, e.g. in development
+func newTokenManager(conf tokens.Config) (*tokens.TokenManager, error) {
+	if len(conf.Keys) > 0 || !conf.GenerateKeys {
+		return tokens.New(conf)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, generatedKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens.NewWithKey(key, conf)
+}
+
 // WithSessionManager sets up the default session manager with a 10 minute ttl
 // with persistence to redis
 func WithSessionManager(rc *redis.Client) ServerOption {
@@ -171,7 +358,14 @@ func WithSessionManager(rc *redis.Client) ServerOption {
 			sm,
 			sessions.WithPersistence(rc),
 			sessions.WithLogger(s.Config.Logger),
-			sessions.WithSkipperFunc(authmw.SessionSkipperFunc),
+			sessions.WithSkipperFunc(func(c echo.Context) bool {
+				// clients authenticating with a bearer token do not have a session
+				if _, err := c.Cookie(cc.Name); err != nil {
+					return true
+				}
+
+				return authmw.SessionSkipperFunc(c)
+			}),
 		)
 
 		// set cookie config to be used
@@ -0,0 +1,208 @@
+package serveropts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/testutils"
+	echo "github.com/datumforge/echox"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/interceptors"
+	"github.com/datumforge/go-template/internal/entdb"
+	"github.com/datumforge/go-template/pkg/middleware/transaction"
+
+	_ "github.com/datumforge/go-template/internal/ent/generated/runtime"
+)
+
+func TestDevUser(t *testing.T) {
+	client, err := entdb.NewTestClient(context.Background(),
+		testutils.GetTestURI("sqlite://file:"+t.Name()+"?mode=memory&cache=shared&_fk=1", 0), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	userID, orgID, err := devUser(context.Background(), client)
+	require.NoError(t, err)
+
+	// the user and its organization are only created on the first start
+	gotUserID, gotOrgID, err := devUser(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, userID, gotUserID)
+	assert.Equal(t, orgID, gotOrgID)
+
+	system := interceptors.SkipTenant(context.Background())
+	assert.Equal(t, 1, client.User.Query().CountX(system))
+	assert.Equal(t, 1, client.Organization.Query().CountX(system))
+
+	// a todo of another organization
+	other := client.User.Create().SetEmail("owner@globex.com").SaveX(system)
+	otherOrg := client.Organization.Create().SetName("globex").SaveX(system)
+	client.OrgMembership.Create().SetUserID(other.ID).SetOrganizationID(otherOrg.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/query", nil), httptest.NewRecorder())
+	otherCtx := auth.AddAuthenticatedUserContext(c, &auth.AuthenticatedUser{
+		SubjectID:          other.ID,
+		OrganizationID:     otherOrg.ID,
+		OrganizationIDs:    []string{otherOrg.ID},
+		AuthenticationType: auth.JWTAuthentication,
+	})
+	client.Todo.Create().SetName("globex todo").SaveX(otherCtx)
+
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/query", nil), httptest.NewRecorder())
+
+	handler := devAuth(userID, orgID)(func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		// the request is scoped to the organization of the development user, it does not run as the system
+		assert.False(t, interceptors.CheckSkipTenant(ctx))
+
+		tenant, err := interceptors.TenantFromContext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, orgID, tenant)
+
+		todo := client.Todo.Create().SetName("dev todo").SaveX(ctx)
+
+		todos := client.Todo.Query().AllX(ctx)
+		require.Len(t, todos, 1)
+		assert.Equal(t, todo.ID, todos[0].ID)
+
+		return nil
+	})
+
+	require.NoError(t, handler(c))
+}
+
+func TestWithAuthRevocations(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	rc := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rc.Close() })
+
+	tests := []struct {
+		name         string
+		redisEnabled bool
+		wantList     bool
+	}{
+		{
+			name:         "redis enabled",
+			redisEnabled: true,
+			wantList:     true,
+		},
+		{
+			name:         "redis disabled",
+			redisEnabled: false,
+			wantList:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			so := &ServerOptions{}
+			so.Config.Logger = zap.NewNop().Sugar()
+			so.Config.Settings.Auth.Enabled = true
+			so.Config.Settings.Auth.Token.GenerateKeys = true
+			so.Config.Settings.Redis.Enabled = tt.redisEnabled
+
+			so.AddServerOptions(WithAuth(rc))
+
+			assert.Equal(t, tt.wantList, so.Config.Handler.TokenRevocations != nil)
+			assert.Len(t, so.Config.GraphMiddleware, 1)
+		})
+	}
+}
+
+func TestIfMatchPrecondition(t *testing.T) {
+	client, err := entdb.NewTestClient(context.Background(),
+		testutils.GetTestURI("sqlite://file:"+t.Name()+"?mode=memory&cache=shared&_fk=1", 0), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	system := interceptors.SkipTenant(context.Background())
+
+	u := client.User.Create().SetEmail("owner@acme.com").SaveX(system)
+	org := client.Organization.Create().SetName("acme").SaveX(system)
+	client.OrgMembership.Create().SetUserID(u.ID).SetOrganizationID(org.ID).SetRole(orgmembership.RoleOWNER).SaveX(system)
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), httptest.NewRecorder())
+	todo := client.Todo.Create().SetName("todo").SaveX(auth.AddAuthenticatedUserContext(c, &auth.AuthenticatedUser{
+		SubjectID:          u.ID,
+		OrganizationID:     org.ID,
+		OrganizationIDs:    []string{org.ID},
+		AuthenticationType: auth.JWTAuthentication,
+	}))
+
+	// the default middleware of the server and a REST route updating the todo in the transaction of the request
+	so := &ServerOptions{}
+	so.Config.Logger = zap.NewNop().Sugar()
+	so.AddServerOptions(WithMiddleware())
+
+	e := echo.New()
+	for _, m := range so.Config.DefaultMiddleware {
+		e.Use(m)
+	}
+
+	tc := transaction.Client{EntDBClient: client, Logger: so.Config.Logger}
+
+	e.PATCH("/todos/:id", func(c echo.Context) error {
+		ctx := interceptors.SkipTenant(c.Request().Context())
+
+		if _, err := transaction.FromContext(ctx).Client().Todo.UpdateOneID(c.PathParam("id")).SetDescription("changed").Save(ctx); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}, tc.Middleware)
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+	}{
+		{
+			name:       "current version",
+			ifMatch:    `"1"`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "stale version",
+			ifMatch:    `"1"`,
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "invalid version",
+			ifMatch:    `"latest"`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "without a version",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/todos/"+todo.ID, nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+
+	// the stale update was rolled back
+	assert.Equal(t, 3, client.Todo.GetX(system, todo.ID).Version)
+}
@@ -6,20 +6,378 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/go-template/internal/ent/generated/orgmembership"
+	"github.com/datumforge/go-template/internal/ent/generated/todo"
+	"github.com/datumforge/go-template/internal/ent/generated/todohistory"
 )
 
+// CreateTagInput is used for create Tag object.
+// Input was generated by ent.
+type CreateTagInput struct {
+	// the name of the tag
+	Name string `json:"name"`
+	// the color of the tag as a hex code, e.g. #1f883d
+	Color *string `json:"color,omitempty"`
+	// an optional description of the tag
+	Description *string `json:"description,omitempty"`
+	OwnerID     *string `json:"ownerID,omitempty"`
+}
+
 // CreateTodoInput is used for create Todo object.
 // Input was generated by ent.
 type CreateTodoInput struct {
-	// the name of the organization
+	// the name of the todo
 	Name string `json:"name"`
-	// An optional description of the organization
+	// an optional description of the todo
 	Description *string `json:"description,omitempty"`
+	// the workflow status of the todo, transitions are enforced by the status hook
+	Status *todo.Status `json:"status,omitempty"`
+	// the priority of the todo, higher values are more urgent
+	Priority *int64 `json:"priority,omitempty"`
+	// the optional time the todo is due
+	DueDate *time.Time `json:"dueDate,omitempty"`
+	OwnerID *string    `json:"ownerID,omitempty"`
+	TagIDs  []string   `json:"tagIDs,omitempty"`
 }
 
 type Mutation struct {
 }
 
+type OrgMembership struct {
+	ID string `json:"id"`
+	// the time the object was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// the time the object was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// the role of the user in the organization
+	Role orgmembership.Role `json:"role"`
+	// the id of the organization
+	OrganizationID string `json:"organizationID"`
+	// the id of the member
+	UserID       string        `json:"userID"`
+	Organization *Organization `json:"organization"`
+	User         *User         `json:"user"`
+}
+
+func (OrgMembership) IsNode() {}
+
+// Ordering options for OrgMembership connections
+type OrgMembershipOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order OrgMemberships.
+	Field OrgMembershipOrderField `json:"field"`
+}
+
+// OrgMembershipWhereInput is used for filtering OrgMembership objects.
+// Input was generated by ent.
+type OrgMembershipWhereInput struct {
+	Not *OrgMembershipWhereInput   `json:"not,omitempty"`
+	And []*OrgMembershipWhereInput `json:"and,omitempty"`
+	Or  []*OrgMembershipWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// role field predicates
+	Role      *orgmembership.Role  `json:"role,omitempty"`
+	RoleNeq   *orgmembership.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []orgmembership.Role `json:"roleIn,omitempty"`
+	RoleNotIn []orgmembership.Role `json:"roleNotIn,omitempty"`
+	// organization_id field predicates
+	OrganizationID             *string  `json:"organizationID,omitempty"`
+	OrganizationIDNeq          *string  `json:"organizationIDNEQ,omitempty"`
+	OrganizationIDIn           []string `json:"organizationIDIn,omitempty"`
+	OrganizationIDNotIn        []string `json:"organizationIDNotIn,omitempty"`
+	OrganizationIDGt           *string  `json:"organizationIDGT,omitempty"`
+	OrganizationIDGte          *string  `json:"organizationIDGTE,omitempty"`
+	OrganizationIDLt           *string  `json:"organizationIDLT,omitempty"`
+	OrganizationIDLte          *string  `json:"organizationIDLTE,omitempty"`
+	OrganizationIDContains     *string  `json:"organizationIDContains,omitempty"`
+	OrganizationIDHasPrefix    *string  `json:"organizationIDHasPrefix,omitempty"`
+	OrganizationIDHasSuffix    *string  `json:"organizationIDHasSuffix,omitempty"`
+	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
+	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
+	// user_id field predicates
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNeq          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGt           *string  `json:"userIDGT,omitempty"`
+	UserIDGte          *string  `json:"userIDGTE,omitempty"`
+	UserIDLt           *string  `json:"userIDLT,omitempty"`
+	UserIDLte          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+	// organization edge predicates
+	HasOrganization     *bool                     `json:"hasOrganization,omitempty"`
+	HasOrganizationWith []*OrganizationWhereInput `json:"hasOrganizationWith,omitempty"`
+	// user edge predicates
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+type Organization struct {
+	ID string `json:"id"`
+	// the time the object was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// the time the object was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// the time the object was deleted
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// the user or system actor that deleted the object
+	DeletedBy *string `json:"deletedBy,omitempty"`
+	// the name of the organization
+	Name string `json:"name"`
+	// an optional description of the organization
+	Description *string          `json:"description,omitempty"`
+	Members     []*OrgMembership `json:"members,omitempty"`
+	Todos       *TodoConnection  `json:"todos"`
+	Tags        *TagConnection   `json:"tags"`
+}
+
+func (Organization) IsNode() {}
+
+// A connection to a list of items.
+type OrganizationConnection struct {
+	// A list of edges.
+	Edges []*OrganizationEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type OrganizationEdge struct {
+	// The item at the end of the edge.
+	Node *Organization `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for Organization connections
+type OrganizationOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Organizations.
+	Field OrganizationOrderField `json:"field"`
+}
+
+// OrganizationWhereInput is used for filtering Organization objects.
+// Input was generated by ent.
+type OrganizationWhereInput struct {
+	Not *OrganizationWhereInput   `json:"not,omitempty"`
+	And []*OrganizationWhereInput `json:"and,omitempty"`
+	Or  []*OrganizationWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// members edge predicates
+	HasMembers     *bool                      `json:"hasMembers,omitempty"`
+	HasMembersWith []*OrgMembershipWhereInput `json:"hasMembersWith,omitempty"`
+	// todos edge predicates
+	HasTodos     *bool             `json:"hasTodos,omitempty"`
+	HasTodosWith []*TodoWhereInput `json:"hasTodosWith,omitempty"`
+	// tags edge predicates
+	HasTags     *bool            `json:"hasTags,omitempty"`
+	HasTagsWith []*TagWhereInput `json:"hasTagsWith,omitempty"`
+}
+
 // Information about pagination in a connection.
 // https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
 type PageInfo struct {
@@ -36,16 +394,276 @@ type PageInfo struct {
 type Query struct {
 }
 
+type Subscription struct {
+}
+
+type Tag struct {
+	ID string `json:"id"`
+	// the time the object was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// the time the object was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// the id of the organization that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the name of the tag
+	Name string `json:"name"`
+	// the color of the tag as a hex code, e.g. #1f883d
+	Color *string `json:"color,omitempty"`
+	// an optional description of the tag
+	Description *string         `json:"description,omitempty"`
+	Owner       *Organization   `json:"owner,omitempty"`
+	Todos       *TodoConnection `json:"todos"`
+}
+
+func (Tag) IsNode() {}
+
+// Return response for createBulkTag mutation
+type TagBulkCreatePayload struct {
+	// Created tags
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// A connection to a list of items.
+type TagConnection struct {
+	// A list of edges.
+	Edges []*TagEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createTag mutation
+type TagCreatePayload struct {
+	// Created tag
+	Tag *Tag `json:"tag"`
+}
+
+// Return response for deleteTag mutation
+type TagDeletePayload struct {
+	// Deleted tag ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type TagEdge struct {
+	// The item at the end of the edge.
+	Node *Tag `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for Tag connections
+type TagOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Tags.
+	Field TagOrderField `json:"field"`
+}
+
+// Return response for updateTag mutation
+type TagUpdatePayload struct {
+	// Updated tag
+	Tag *Tag `json:"tag"`
+}
+
+// TagWhereInput is used for filtering Tag objects.
+// Input was generated by ent.
+type TagWhereInput struct {
+	Not *TagWhereInput   `json:"not,omitempty"`
+	And []*TagWhereInput `json:"and,omitempty"`
+	Or  []*TagWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// color field predicates
+	Color             *string  `json:"color,omitempty"`
+	ColorNeq          *string  `json:"colorNEQ,omitempty"`
+	ColorIn           []string `json:"colorIn,omitempty"`
+	ColorNotIn        []string `json:"colorNotIn,omitempty"`
+	ColorGt           *string  `json:"colorGT,omitempty"`
+	ColorGte          *string  `json:"colorGTE,omitempty"`
+	ColorLt           *string  `json:"colorLT,omitempty"`
+	ColorLte          *string  `json:"colorLTE,omitempty"`
+	ColorContains     *string  `json:"colorContains,omitempty"`
+	ColorHasPrefix    *string  `json:"colorHasPrefix,omitempty"`
+	ColorHasSuffix    *string  `json:"colorHasSuffix,omitempty"`
+	ColorIsNil        *bool    `json:"colorIsNil,omitempty"`
+	ColorNotNil       *bool    `json:"colorNotNil,omitempty"`
+	ColorEqualFold    *string  `json:"colorEqualFold,omitempty"`
+	ColorContainsFold *string  `json:"colorContainsFold,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// todos edge predicates
+	HasTodos     *bool             `json:"hasTodos,omitempty"`
+	HasTodosWith []*TodoWhereInput `json:"hasTodosWith,omitempty"`
+}
+
 type Todo struct {
 	ID string `json:"id"`
-	// the name of the organization
+	// the time the object was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// the time the object was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// the time the object was deleted
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// the user or system actor that deleted the object
+	DeletedBy *string `json:"deletedBy,omitempty"`
+	// the id of the organization that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the name of the todo
 	Name string `json:"name"`
-	// An optional description of the organization
+	// an optional description of the todo
 	Description *string `json:"description,omitempty"`
+	// the workflow status of the todo, transitions are enforced by the status hook
+	Status todo.Status `json:"status"`
+	// the priority of the todo, higher values are more urgent
+	Priority int64 `json:"priority"`
+	// the optional time the todo is due
+	DueDate *time.Time `json:"dueDate,omitempty"`
+	// the time the todo was completed, set when the status changes to DONE
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// the version of the todo, incremented on every update and used to detect concurrent changes
+	Version int64          `json:"version"`
+	Owner   *Organization  `json:"owner,omitempty"`
+	Tags    *TagConnection `json:"tags"`
 }
 
 func (Todo) IsNode() {}
 
+// Return response for createBulkTodo mutation
+type TodoBulkCreatePayload struct {
+	// Created todos
+	Todos []*Todo `json:"todos,omitempty"`
+}
+
+// A connection to a list of items.
+type TodoConnection struct {
+	// A list of edges.
+	Edges []*TodoEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
 // Return response for createTodo mutation
 type TodoCreatePayload struct {
 	// Created todo
@@ -58,6 +676,324 @@ type TodoDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+// An edge in a connection.
+type TodoEdge struct {
+	// The item at the end of the edge.
+	Node *Todo `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+	// Relevance of the match when the todo is found by searchTodos, higher ranks are more relevant
+	Rank *float64 `json:"rank,omitempty"`
+	// Fields of the todo with the matched terms wrapped in <mark> tags when the todo is found by searchTodos, the rest of the text is HTML escaped
+	Highlight *TodoSearchHighlight `json:"highlight,omitempty"`
+}
+
+type TodoHistory struct {
+	ID          string            `json:"id"`
+	HistoryTime time.Time         `json:"historyTime"`
+	Ref         *string           `json:"ref,omitempty"`
+	Operation   enthistory.OpType `json:"operation"`
+	// the time the object was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// the time the object was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// the time the object was deleted
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// the user or system actor that deleted the object
+	DeletedBy *string `json:"deletedBy,omitempty"`
+	// the id of the organization that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the name of the todo
+	Name string `json:"name"`
+	// an optional description of the todo
+	Description *string `json:"description,omitempty"`
+	// the workflow status of the todo, transitions are enforced by the status hook
+	Status todohistory.Status `json:"status"`
+	// the priority of the todo, higher values are more urgent
+	Priority int64 `json:"priority"`
+	// the optional time the todo is due
+	DueDate *time.Time `json:"dueDate,omitempty"`
+	// the time the todo was completed, set when the status changes to DONE
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// the version of the todo, incremented on every update and used to detect concurrent changes
+	Version int64 `json:"version"`
+}
+
+func (TodoHistory) IsNode() {}
+
+// A connection to a list of items.
+type TodoHistoryConnection struct {
+	// A list of edges.
+	Edges []*TodoHistoryEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type TodoHistoryEdge struct {
+	// The item at the end of the edge.
+	Node *TodoHistory `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for TodoHistory connections
+type TodoHistoryOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order TodoHistories.
+	Field TodoHistoryOrderField `json:"field"`
+}
+
+// TodoHistoryWhereInput is used for filtering TodoHistory objects.
+// Input was generated by ent.
+type TodoHistoryWhereInput struct {
+	Not *TodoHistoryWhereInput   `json:"not,omitempty"`
+	And []*TodoHistoryWhereInput `json:"and,omitempty"`
+	Or  []*TodoHistoryWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// history_time field predicates
+	HistoryTime      *time.Time   `json:"historyTime,omitempty"`
+	HistoryTimeNeq   *time.Time   `json:"historyTimeNEQ,omitempty"`
+	HistoryTimeIn    []*time.Time `json:"historyTimeIn,omitempty"`
+	HistoryTimeNotIn []*time.Time `json:"historyTimeNotIn,omitempty"`
+	HistoryTimeGt    *time.Time   `json:"historyTimeGT,omitempty"`
+	HistoryTimeGte   *time.Time   `json:"historyTimeGTE,omitempty"`
+	HistoryTimeLt    *time.Time   `json:"historyTimeLT,omitempty"`
+	HistoryTimeLte   *time.Time   `json:"historyTimeLTE,omitempty"`
+	// ref field predicates
+	Ref             *string  `json:"ref,omitempty"`
+	RefNeq          *string  `json:"refNEQ,omitempty"`
+	RefIn           []string `json:"refIn,omitempty"`
+	RefNotIn        []string `json:"refNotIn,omitempty"`
+	RefGt           *string  `json:"refGT,omitempty"`
+	RefGte          *string  `json:"refGTE,omitempty"`
+	RefLt           *string  `json:"refLT,omitempty"`
+	RefLte          *string  `json:"refLTE,omitempty"`
+	RefContains     *string  `json:"refContains,omitempty"`
+	RefHasPrefix    *string  `json:"refHasPrefix,omitempty"`
+	RefHasSuffix    *string  `json:"refHasSuffix,omitempty"`
+	RefIsNil        *bool    `json:"refIsNil,omitempty"`
+	RefNotNil       *bool    `json:"refNotNil,omitempty"`
+	RefEqualFold    *string  `json:"refEqualFold,omitempty"`
+	RefContainsFold *string  `json:"refContainsFold,omitempty"`
+	// operation field predicates
+	Operation      *enthistory.OpType  `json:"operation,omitempty"`
+	OperationNeq   *enthistory.OpType  `json:"operationNEQ,omitempty"`
+	OperationIn    []enthistory.OpType `json:"operationIn,omitempty"`
+	OperationNotIn []enthistory.OpType `json:"operationNotIn,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// status field predicates
+	Status      *todohistory.Status  `json:"status,omitempty"`
+	StatusNeq   *todohistory.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []todohistory.Status `json:"statusIn,omitempty"`
+	StatusNotIn []todohistory.Status `json:"statusNotIn,omitempty"`
+	// priority field predicates
+	Priority      *int64  `json:"priority,omitempty"`
+	PriorityNeq   *int64  `json:"priorityNEQ,omitempty"`
+	PriorityIn    []int64 `json:"priorityIn,omitempty"`
+	PriorityNotIn []int64 `json:"priorityNotIn,omitempty"`
+	PriorityGt    *int64  `json:"priorityGT,omitempty"`
+	PriorityGte   *int64  `json:"priorityGTE,omitempty"`
+	PriorityLt    *int64  `json:"priorityLT,omitempty"`
+	PriorityLte   *int64  `json:"priorityLTE,omitempty"`
+	// due_date field predicates
+	DueDate       *time.Time   `json:"dueDate,omitempty"`
+	DueDateNeq    *time.Time   `json:"dueDateNEQ,omitempty"`
+	DueDateIn     []*time.Time `json:"dueDateIn,omitempty"`
+	DueDateNotIn  []*time.Time `json:"dueDateNotIn,omitempty"`
+	DueDateGt     *time.Time   `json:"dueDateGT,omitempty"`
+	DueDateGte    *time.Time   `json:"dueDateGTE,omitempty"`
+	DueDateLt     *time.Time   `json:"dueDateLT,omitempty"`
+	DueDateLte    *time.Time   `json:"dueDateLTE,omitempty"`
+	DueDateIsNil  *bool        `json:"dueDateIsNil,omitempty"`
+	DueDateNotNil *bool        `json:"dueDateNotNil,omitempty"`
+	// completed_at field predicates
+	CompletedAt       *time.Time   `json:"completedAt,omitempty"`
+	CompletedAtNeq    *time.Time   `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []*time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []*time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGt     *time.Time   `json:"completedAtGT,omitempty"`
+	CompletedAtGte    *time.Time   `json:"completedAtGTE,omitempty"`
+	CompletedAtLt     *time.Time   `json:"completedAtLT,omitempty"`
+	CompletedAtLte    *time.Time   `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  *bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil *bool        `json:"completedAtNotNil,omitempty"`
+	// version field predicates
+	Version      *int64  `json:"version,omitempty"`
+	VersionNeq   *int64  `json:"versionNEQ,omitempty"`
+	VersionIn    []int64 `json:"versionIn,omitempty"`
+	VersionNotIn []int64 `json:"versionNotIn,omitempty"`
+	VersionGt    *int64  `json:"versionGT,omitempty"`
+	VersionGte   *int64  `json:"versionGTE,omitempty"`
+	VersionLt    *int64  `json:"versionLT,omitempty"`
+	VersionLte   *int64  `json:"versionLTE,omitempty"`
+}
+
+// Ordering options for Todo connections
+type TodoOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Todos.
+	Field TodoOrderField `json:"field"`
+}
+
+// Return response for restoreTodo mutation
+type TodoRestorePayload struct {
+	// Restored todo
+	Todo *Todo `json:"todo"`
+}
+
+// Fields of a todo with the matched terms highlighted
+type TodoSearchHighlight struct {
+	// Name of the todo
+	Name string `json:"name"`
+	// Snippet of the description of the todo
+	Description *string `json:"description,omitempty"`
+}
+
 // Return response for updateTodo mutation
 type TodoUpdatePayload struct {
 	// Updated todo
@@ -81,6 +1017,103 @@ type TodoWhereInput struct {
 	IDLte          *string  `json:"idLTE,omitempty"`
 	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
 	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
 	// name field predicates
 	Name             *string  `json:"name,omitempty"`
 	NameNeq          *string  `json:"nameNEQ,omitempty"`
@@ -111,16 +1144,254 @@ type TodoWhereInput struct {
 	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
 	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
 	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// status field predicates
+	Status      *todo.Status  `json:"status,omitempty"`
+	StatusNeq   *todo.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []todo.Status `json:"statusIn,omitempty"`
+	StatusNotIn []todo.Status `json:"statusNotIn,omitempty"`
+	// priority field predicates
+	Priority      *int64  `json:"priority,omitempty"`
+	PriorityNeq   *int64  `json:"priorityNEQ,omitempty"`
+	PriorityIn    []int64 `json:"priorityIn,omitempty"`
+	PriorityNotIn []int64 `json:"priorityNotIn,omitempty"`
+	PriorityGt    *int64  `json:"priorityGT,omitempty"`
+	PriorityGte   *int64  `json:"priorityGTE,omitempty"`
+	PriorityLt    *int64  `json:"priorityLT,omitempty"`
+	PriorityLte   *int64  `json:"priorityLTE,omitempty"`
+	// due_date field predicates
+	DueDate       *time.Time   `json:"dueDate,omitempty"`
+	DueDateNeq    *time.Time   `json:"dueDateNEQ,omitempty"`
+	DueDateIn     []*time.Time `json:"dueDateIn,omitempty"`
+	DueDateNotIn  []*time.Time `json:"dueDateNotIn,omitempty"`
+	DueDateGt     *time.Time   `json:"dueDateGT,omitempty"`
+	DueDateGte    *time.Time   `json:"dueDateGTE,omitempty"`
+	DueDateLt     *time.Time   `json:"dueDateLT,omitempty"`
+	DueDateLte    *time.Time   `json:"dueDateLTE,omitempty"`
+	DueDateIsNil  *bool        `json:"dueDateIsNil,omitempty"`
+	DueDateNotNil *bool        `json:"dueDateNotNil,omitempty"`
+	// completed_at field predicates
+	CompletedAt       *time.Time   `json:"completedAt,omitempty"`
+	CompletedAtNeq    *time.Time   `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []*time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []*time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGt     *time.Time   `json:"completedAtGT,omitempty"`
+	CompletedAtGte    *time.Time   `json:"completedAtGTE,omitempty"`
+	CompletedAtLt     *time.Time   `json:"completedAtLT,omitempty"`
+	CompletedAtLte    *time.Time   `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  *bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil *bool        `json:"completedAtNotNil,omitempty"`
+	// version field predicates
+	Version      *int64  `json:"version,omitempty"`
+	VersionNeq   *int64  `json:"versionNEQ,omitempty"`
+	VersionIn    []int64 `json:"versionIn,omitempty"`
+	VersionNotIn []int64 `json:"versionNotIn,omitempty"`
+	VersionGt    *int64  `json:"versionGT,omitempty"`
+	VersionGte   *int64  `json:"versionGTE,omitempty"`
+	VersionLt    *int64  `json:"versionLT,omitempty"`
+	VersionLte   *int64  `json:"versionLTE,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// tags edge predicates
+	HasTags     *bool            `json:"hasTags,omitempty"`
+	HasTagsWith []*TagWhereInput `json:"hasTagsWith,omitempty"`
+}
+
+// UpdateTagInput is used for update Tag object.
+// Input was generated by ent.
+type UpdateTagInput struct {
+	// the name of the tag
+	Name *string `json:"name,omitempty"`
+	// the color of the tag as a hex code, e.g. #1f883d
+	Color      *string `json:"color,omitempty"`
+	ClearColor *bool   `json:"clearColor,omitempty"`
+	// an optional description of the tag
+	Description      *string `json:"description,omitempty"`
+	ClearDescription *bool   `json:"clearDescription,omitempty"`
 }
 
 // UpdateTodoInput is used for update Todo object.
 // Input was generated by ent.
 type UpdateTodoInput struct {
-	// the name of the organization
+	// the name of the todo
 	Name *string `json:"name,omitempty"`
-	// An optional description of the organization
+	// an optional description of the todo
 	Description      *string `json:"description,omitempty"`
 	ClearDescription *bool   `json:"clearDescription,omitempty"`
+	// the workflow status of the todo, transitions are enforced by the status hook
+	Status *todo.Status `json:"status,omitempty"`
+	// the priority of the todo, higher values are more urgent
+	Priority *int64 `json:"priority,omitempty"`
+	// the optional time the todo is due
+	DueDate      *time.Time `json:"dueDate,omitempty"`
+	ClearDueDate *bool      `json:"clearDueDate,omitempty"`
+	AddTagIDs    []string   `json:"addTagIDs,omitempty"`
+	RemoveTagIDs []string   `json:"removeTagIDs,omitempty"`
+	ClearTags    *bool      `json:"clearTags,omitempty"`
+}
+
+type User struct {
+	ID string `json:"id"`
+	// the time the object was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// the time the object was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// the user or system actor that created the object
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// the user or system actor that last updated the object
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// the time the object was deleted
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// the user or system actor that deleted the object
+	DeletedBy *string `json:"deletedBy,omitempty"`
+	// the email address of the user
+	Email string `json:"email"`
+	// the name of the user shown to other users
+	DisplayName *string          `json:"displayName,omitempty"`
+	Memberships []*OrgMembership `json:"memberships,omitempty"`
+}
+
+func (User) IsNode() {}
+
+// Ordering options for User connections
+type UserOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Users.
+	Field UserOrderField `json:"field"`
+}
+
+// UserWhereInput is used for filtering User objects.
+// Input was generated by ent.
+type UserWhereInput struct {
+	Not *UserWhereInput   `json:"not,omitempty"`
+	And []*UserWhereInput `json:"and,omitempty"`
+	Or  []*UserWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// email field predicates
+	Email             *string  `json:"email,omitempty"`
+	EmailNeq          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGt           *string  `json:"emailGT,omitempty"`
+	EmailGte          *string  `json:"emailGTE,omitempty"`
+	EmailLt           *string  `json:"emailLT,omitempty"`
+	EmailLte          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
+	// display_name field predicates
+	DisplayName             *string  `json:"displayName,omitempty"`
+	DisplayNameNeq          *string  `json:"displayNameNEQ,omitempty"`
+	DisplayNameIn           []string `json:"displayNameIn,omitempty"`
+	DisplayNameNotIn        []string `json:"displayNameNotIn,omitempty"`
+	DisplayNameGt           *string  `json:"displayNameGT,omitempty"`
+	DisplayNameGte          *string  `json:"displayNameGTE,omitempty"`
+	DisplayNameLt           *string  `json:"displayNameLT,omitempty"`
+	DisplayNameLte          *string  `json:"displayNameLTE,omitempty"`
+	DisplayNameContains     *string  `json:"displayNameContains,omitempty"`
+	DisplayNameHasPrefix    *string  `json:"displayNameHasPrefix,omitempty"`
+	DisplayNameHasSuffix    *string  `json:"displayNameHasSuffix,omitempty"`
+	DisplayNameIsNil        *bool    `json:"displayNameIsNil,omitempty"`
+	DisplayNameNotNil       *bool    `json:"displayNameNotNil,omitempty"`
+	DisplayNameEqualFold    *string  `json:"displayNameEqualFold,omitempty"`
+	DisplayNameContainsFold *string  `json:"displayNameContainsFold,omitempty"`
+	// memberships edge predicates
+	HasMemberships     *bool                      `json:"hasMemberships,omitempty"`
+	HasMembershipsWith []*OrgMembershipWhereInput `json:"hasMembershipsWith,omitempty"`
 }
 
 // Possible directions in which to order a list of items when provided an `orderBy` argument.
@@ -166,3 +1437,285 @@ func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
 func (e OrderDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// Properties by which OrgMembership connections can be ordered.
+type OrgMembershipOrderField string
+
+const (
+	OrgMembershipOrderFieldCreatedAt OrgMembershipOrderField = "created_at"
+	OrgMembershipOrderFieldUpdatedAt OrgMembershipOrderField = "updated_at"
+	OrgMembershipOrderFieldRole      OrgMembershipOrderField = "role"
+)
+
+var AllOrgMembershipOrderField = []OrgMembershipOrderField{
+	OrgMembershipOrderFieldCreatedAt,
+	OrgMembershipOrderFieldUpdatedAt,
+	OrgMembershipOrderFieldRole,
+}
+
+func (e OrgMembershipOrderField) IsValid() bool {
+	switch e {
+	case OrgMembershipOrderFieldCreatedAt, OrgMembershipOrderFieldUpdatedAt, OrgMembershipOrderFieldRole:
+		return true
+	}
+	return false
+}
+
+func (e OrgMembershipOrderField) String() string {
+	return string(e)
+}
+
+func (e *OrgMembershipOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrgMembershipOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrgMembershipOrderField", str)
+	}
+	return nil
+}
+
+func (e OrgMembershipOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which Organization connections can be ordered.
+type OrganizationOrderField string
+
+const (
+	OrganizationOrderFieldCreatedAt OrganizationOrderField = "created_at"
+	OrganizationOrderFieldUpdatedAt OrganizationOrderField = "updated_at"
+	OrganizationOrderFieldName      OrganizationOrderField = "name"
+)
+
+var AllOrganizationOrderField = []OrganizationOrderField{
+	OrganizationOrderFieldCreatedAt,
+	OrganizationOrderFieldUpdatedAt,
+	OrganizationOrderFieldName,
+}
+
+func (e OrganizationOrderField) IsValid() bool {
+	switch e {
+	case OrganizationOrderFieldCreatedAt, OrganizationOrderFieldUpdatedAt, OrganizationOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e OrganizationOrderField) String() string {
+	return string(e)
+}
+
+func (e *OrganizationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrganizationOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationOrderField", str)
+	}
+	return nil
+}
+
+func (e OrganizationOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which Tag connections can be ordered.
+type TagOrderField string
+
+const (
+	TagOrderFieldCreatedAt TagOrderField = "created_at"
+	TagOrderFieldUpdatedAt TagOrderField = "updated_at"
+	TagOrderFieldName      TagOrderField = "name"
+)
+
+var AllTagOrderField = []TagOrderField{
+	TagOrderFieldCreatedAt,
+	TagOrderFieldUpdatedAt,
+	TagOrderFieldName,
+}
+
+func (e TagOrderField) IsValid() bool {
+	switch e {
+	case TagOrderFieldCreatedAt, TagOrderFieldUpdatedAt, TagOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e TagOrderField) String() string {
+	return string(e)
+}
+
+func (e *TagOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TagOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TagOrderField", str)
+	}
+	return nil
+}
+
+func (e TagOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which TodoHistory connections can be ordered.
+type TodoHistoryOrderField string
+
+const (
+	TodoHistoryOrderFieldCreatedAt   TodoHistoryOrderField = "created_at"
+	TodoHistoryOrderFieldUpdatedAt   TodoHistoryOrderField = "updated_at"
+	TodoHistoryOrderFieldName        TodoHistoryOrderField = "name"
+	TodoHistoryOrderFieldStatus      TodoHistoryOrderField = "status"
+	TodoHistoryOrderFieldPriority    TodoHistoryOrderField = "priority"
+	TodoHistoryOrderFieldDueDate     TodoHistoryOrderField = "due_date"
+	TodoHistoryOrderFieldCompletedAt TodoHistoryOrderField = "completed_at"
+)
+
+var AllTodoHistoryOrderField = []TodoHistoryOrderField{
+	TodoHistoryOrderFieldCreatedAt,
+	TodoHistoryOrderFieldUpdatedAt,
+	TodoHistoryOrderFieldName,
+	TodoHistoryOrderFieldStatus,
+	TodoHistoryOrderFieldPriority,
+	TodoHistoryOrderFieldDueDate,
+	TodoHistoryOrderFieldCompletedAt,
+}
+
+func (e TodoHistoryOrderField) IsValid() bool {
+	switch e {
+	case TodoHistoryOrderFieldCreatedAt, TodoHistoryOrderFieldUpdatedAt, TodoHistoryOrderFieldName, TodoHistoryOrderFieldStatus, TodoHistoryOrderFieldPriority, TodoHistoryOrderFieldDueDate, TodoHistoryOrderFieldCompletedAt:
+		return true
+	}
+	return false
+}
+
+func (e TodoHistoryOrderField) String() string {
+	return string(e)
+}
+
+func (e *TodoHistoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoHistoryOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoHistoryOrderField", str)
+	}
+	return nil
+}
+
+func (e TodoHistoryOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which Todo connections can be ordered.
+type TodoOrderField string
+
+const (
+	TodoOrderFieldCreatedAt   TodoOrderField = "created_at"
+	TodoOrderFieldUpdatedAt   TodoOrderField = "updated_at"
+	TodoOrderFieldName        TodoOrderField = "name"
+	TodoOrderFieldStatus      TodoOrderField = "status"
+	TodoOrderFieldPriority    TodoOrderField = "priority"
+	TodoOrderFieldDueDate     TodoOrderField = "due_date"
+	TodoOrderFieldCompletedAt TodoOrderField = "completed_at"
+)
+
+var AllTodoOrderField = []TodoOrderField{
+	TodoOrderFieldCreatedAt,
+	TodoOrderFieldUpdatedAt,
+	TodoOrderFieldName,
+	TodoOrderFieldStatus,
+	TodoOrderFieldPriority,
+	TodoOrderFieldDueDate,
+	TodoOrderFieldCompletedAt,
+}
+
+func (e TodoOrderField) IsValid() bool {
+	switch e {
+	case TodoOrderFieldCreatedAt, TodoOrderFieldUpdatedAt, TodoOrderFieldName, TodoOrderFieldStatus, TodoOrderFieldPriority, TodoOrderFieldDueDate, TodoOrderFieldCompletedAt:
+		return true
+	}
+	return false
+}
+
+func (e TodoOrderField) String() string {
+	return string(e)
+}
+
+func (e *TodoOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoOrderField", str)
+	}
+	return nil
+}
+
+func (e TodoOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which User connections can be ordered.
+type UserOrderField string
+
+const (
+	UserOrderFieldCreatedAt   UserOrderField = "created_at"
+	UserOrderFieldUpdatedAt   UserOrderField = "updated_at"
+	UserOrderFieldEmail       UserOrderField = "email"
+	UserOrderFieldDisplayName UserOrderField = "display_name"
+)
+
+var AllUserOrderField = []UserOrderField{
+	UserOrderFieldCreatedAt,
+	UserOrderFieldUpdatedAt,
+	UserOrderFieldEmail,
+	UserOrderFieldDisplayName,
+}
+
+func (e UserOrderField) IsValid() bool {
+	switch e {
+	case UserOrderFieldCreatedAt, UserOrderFieldUpdatedAt, UserOrderFieldEmail, UserOrderFieldDisplayName:
+		return true
+	}
+	return false
+}
+
+func (e UserOrderField) String() string {
+	return string(e)
+}
+
+func (e *UserOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserOrderField", str)
+	}
+	return nil
+}
+
+func (e UserOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
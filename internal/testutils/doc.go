@@ -0,0 +1,2 @@
+// Package testutils contains the helpers shared by the tests of the ent schemas, the graph api and the cli
+package testutils
@@ -0,0 +1,40 @@
+package testutils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datumforge/datum/pkg/auth"
+	datumtestutils "github.com/datumforge/datum/pkg/testutils"
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/entdb"
+)
+
+// NewTestClient returns an ent client backed by an in-memory sqlite database named after the test, the
+// client is closed when the test finishes
+func NewTestClient(t *testing.T, opts ...ent.Option) *ent.Client {
+	t.Helper()
+
+	client, err := entdb.NewTestClient(context.Background(),
+		datumtestutils.GetTestURI("sqlite://file:"+t.Name()+"?mode=memory&cache=shared&_fk=1", 0), opts)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+// UserContext returns a context authenticated as the user in the organization
+func UserContext(userID, orgID string) context.Context {
+	return auth.AddAuthenticatedUserContext(echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), httptest.NewRecorder()), &auth.AuthenticatedUser{
+		SubjectID:          userID,
+		OrganizationID:     orgID,
+		OrganizationIDs:    []string{orgID},
+		AuthenticationType: auth.JWTAuthentication,
+	})
+}
@@ -34,6 +34,7 @@ const (
 var includedPackages = []string{
 	"./config",
 	"./internal/entdb",
+	"./internal/events",
 	"./internal/httpserve/handlers",
 }
 
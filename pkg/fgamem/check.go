@@ -0,0 +1,140 @@
+package fgamem
+
+import (
+	"fmt"
+	"strings"
+
+	openfga "github.com/openfga/go-sdk"
+)
+
+// maxResolutionDepth is the maximum number of nested relations resolved for a check, the same as the openFGA default
+const maxResolutionDepth = 25
+
+// checker resolves checks against the tuples of a store with an authorization model
+type checker struct {
+	st *store
+	// relations are the relations of each type in the authorization model
+	relations map[string]map[string]openfga.Userset
+}
+
+// newChecker returns a checker for the store using the authorization model
+func newChecker(st *store, model openfga.AuthorizationModel) *checker {
+	c := &checker{
+		st:        st,
+		relations: map[string]map[string]openfga.Userset{},
+	}
+
+	for _, td := range model.TypeDefinitions {
+		c.relations[td.Type] = td.GetRelations()
+	}
+
+	return c
+}
+
+// check returns whether the user has the relation with the object
+func (c *checker) check(user, relation, object string, depth int) (bool, error) {
+	if depth > maxResolutionDepth {
+		return false, ErrResolutionDepthExceeded
+	}
+
+	objectType, _, _ := strings.Cut(object, ":")
+
+	us, ok := c.relations[objectType][relation]
+	if !ok {
+		return false, fmt.Errorf("%w: %s#%s", ErrUnknownRelation, objectType, relation)
+	}
+
+	return c.resolve(us, user, relation, object, depth)
+}
+
+// resolve returns whether the user is in the userset defining the relation of the object
+func (c *checker) resolve(us openfga.Userset, user, relation, object string, depth int) (bool, error) {
+	switch {
+	case us.This != nil:
+		return c.direct(user, relation, object, depth)
+	case us.ComputedUserset != nil:
+		return c.check(user, us.ComputedUserset.GetRelation(), object, depth+1)
+	case us.TupleToUserset != nil:
+		return c.tupleToUserset(*us.TupleToUserset, user, object, depth)
+	case us.Union != nil:
+		for _, child := range us.Union.Child {
+			ok, err := c.resolve(child, user, relation, object, depth)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+	case us.Intersection != nil:
+		for _, child := range us.Intersection.Child {
+			ok, err := c.resolve(child, user, relation, object, depth)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return len(us.Intersection.Child) > 0, nil
+	case us.Difference != nil:
+		ok, err := c.resolve(us.Difference.Base, user, relation, object, depth)
+		if err != nil || !ok {
+			return false, err
+		}
+
+		excluded, err := c.resolve(us.Difference.Subtract, user, relation, object, depth)
+
+		return !excluded, err
+	}
+
+	return false, nil
+}
+
+// direct returns whether a tuple relates the user to the object, either the user itself, a wildcard of
+// the type of the user, or a userset the user is part of, e.g. `organization:datum#member`
+func (c *checker) direct(user, relation, object string, depth int) (bool, error) {
+	userType, _, _ := strings.Cut(user, ":")
+
+	for k := range c.st.tuples {
+		if k.object != object || k.relation != relation {
+			continue
+		}
+
+		if k.user == user || k.user == userType+":*" {
+			return true, nil
+		}
+
+		if userset, rel, ok := strings.Cut(k.user, "#"); ok {
+			allowed, err := c.check(user, rel, userset, depth+1)
+			if err != nil || allowed {
+				return allowed, err
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// tupleToUserset returns whether the user has the computed relation with any object related to the
+// object by the tupleset relation, e.g. `member from parent`
+func (c *checker) tupleToUserset(ttu openfga.TupleToUserset, user, object string, depth int) (bool, error) {
+	tupleset := ttu.Tupleset.GetRelation()
+	computed := ttu.ComputedUserset.GetRelation()
+
+	for k := range c.st.tuples {
+		if k.object != object || k.relation != tupleset {
+			continue
+		}
+
+		// like openFGA, the related objects without the computed relation are ignored
+		parentType, _, _ := strings.Cut(k.user, ":")
+		if _, ok := c.relations[parentType][computed]; !ok {
+			continue
+		}
+
+		allowed, err := c.check(user, computed, k.user, depth+1)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,3 @@
+// Package fgamem is an in-memory stand-in for the openFGA service, it implements the parts of the http api
+// used by fgax so authorization can be exercised without running openFGA
+package fgamem
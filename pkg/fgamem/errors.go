@@ -0,0 +1,10 @@
+package fgamem
+
+import "errors"
+
+var (
+	// ErrResolutionDepthExceeded is returned when a check resolves more nested relations than allowed
+	ErrResolutionDepthExceeded = errors.New("resolution depth exceeded")
+	// ErrUnknownRelation is returned when a check uses a type or relation that is not in the authorization model
+	ErrUnknownRelation = errors.New("relation not found in authorization model")
+)
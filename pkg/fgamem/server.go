@@ -0,0 +1,482 @@
+package fgamem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	openfga "github.com/openfga/go-sdk"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/utils/ulids"
+	"github.com/datumforge/fgax"
+)
+
+// storeName is the name of the store created by NewClient
+const storeName = "fgamem"
+
+// defaultMaxTuplesPerWrite is the maximum number of tuples of a single write, the same as the openFGA default
+const defaultMaxTuplesPerWrite = 100
+
+// Server serves the openFGA http api from memory, the relationship tuples are lost when it is closed
+type Server struct {
+	mu sync.RWMutex
+	// stores are the stores by id
+	stores map[string]*store
+	// storeIDs are the ids of the stores in the order they were created
+	storeIDs []string
+	// maxTuplesPerWrite is the maximum number of tuples written and deleted in a single write
+	maxTuplesPerWrite int
+	// srv is the http server listening on a local port
+	srv *httptest.Server
+}
+
+// store holds the authorization models and relationship tuples of a single openFGA store
+type store struct {
+	info openfga.Store
+	// models are the authorization models of the store, the latest first
+	models []openfga.AuthorizationModel
+	// tuples are the relationship tuples of the store and the time they were written
+	tuples map[tupleKey]time.Time
+}
+
+// tupleKey identifies a relationship tuple, conditions are not supported
+type tupleKey struct {
+	user     string
+	relation string
+	object   string
+}
+
+// NewServer starts a new in-memory openFGA server listening on a local port
+func NewServer() *Server {
+	s := &Server{
+		stores:            map[string]*store{},
+		maxTuplesPerWrite: defaultMaxTuplesPerWrite,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /stores", s.listStores)
+	mux.HandleFunc("POST /stores", s.createStore)
+	mux.HandleFunc("GET /stores/{store_id}/authorization-models", s.readAuthorizationModels)
+	mux.HandleFunc("POST /stores/{store_id}/authorization-models", s.writeAuthorizationModel)
+	mux.HandleFunc("GET /stores/{store_id}/authorization-models/{id}", s.readAuthorizationModel)
+	mux.HandleFunc("POST /stores/{store_id}/check", s.check)
+	mux.HandleFunc("POST /stores/{store_id}/list-objects", s.listObjects)
+	mux.HandleFunc("POST /stores/{store_id}/read", s.read)
+	mux.HandleFunc("POST /stores/{store_id}/write", s.write)
+
+	s.srv = httptest.NewServer(mux)
+
+	return s
+}
+
+// URL returns the base url of the server
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// SetMaxTuplesPerWrite sets the maximum number of tuples written and deleted in a single write, like the
+// max tuples per write setting of openFGA the whole write fails when it has more tuples
+func (s *Server) SetMaxTuplesPerWrite(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.maxTuplesPerWrite = n
+}
+
+// NewClient returns a client of the server with a store and the model from the file, created the same way
+// as the clients of the openFGA service
+func (s *Server) NewClient(ctx context.Context, modelFile string, l *zap.SugaredLogger) (*fgax.Client, error) {
+	return fgax.CreateFGAClientWithStore(ctx, fgax.Config{
+		Enabled:   true,
+		StoreName: storeName,
+		HostURL:   s.URL(),
+		ModelFile: modelFile,
+	}, l)
+}
+
+// listStores returns all stores, the stores are not paginated
+func (s *Server) listStores(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resp := openfga.ListStoresResponse{
+		Stores: make([]openfga.Store, 0, len(s.storeIDs)),
+	}
+
+	for _, id := range s.storeIDs {
+		resp.Stores = append(resp.Stores, s.stores[id].info)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// createStore creates a new empty store
+func (s *Server) createStore(w http.ResponseWriter, r *http.Request) {
+	var req openfga.CreateStoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+
+		return
+	}
+
+	now := time.Now().UTC()
+
+	st := &store{
+		info: openfga.Store{
+			Id:        ulids.New().String(),
+			Name:      req.Name,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		tuples: map[tupleKey]time.Time{},
+	}
+
+	s.mu.Lock()
+	s.stores[st.info.Id] = st
+	s.storeIDs = append(s.storeIDs, st.info.Id)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, openfga.CreateStoreResponse{
+		Id:        st.info.Id,
+		Name:      st.info.Name,
+		CreatedAt: st.info.CreatedAt,
+		UpdatedAt: st.info.UpdatedAt,
+	})
+}
+
+// readAuthorizationModels returns the authorization models of the store, the latest first
+func (s *Server) readAuthorizationModels(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, openfga.ReadAuthorizationModelsResponse{
+		AuthorizationModels: append([]openfga.AuthorizationModel{}, st.models...),
+	})
+}
+
+// writeAuthorizationModel adds a new authorization model to the store, it becomes the latest model
+func (s *Server) writeAuthorizationModel(w http.ResponseWriter, r *http.Request) {
+	var req openfga.WriteAuthorizationModelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_authorization_model", err.Error())
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	model := openfga.AuthorizationModel{
+		Id:              ulids.New().String(),
+		SchemaVersion:   req.SchemaVersion,
+		TypeDefinitions: req.TypeDefinitions,
+		Conditions:      req.Conditions,
+	}
+
+	st.models = append([]openfga.AuthorizationModel{model}, st.models...)
+
+	writeJSON(w, http.StatusCreated, openfga.WriteAuthorizationModelResponse{
+		AuthorizationModelId: model.Id,
+	})
+}
+
+// readAuthorizationModel returns a single authorization model of the store
+func (s *Server) readAuthorizationModel(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	model, ok := st.model(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "authorization_model_not_found", "authorization model not found")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, openfga.ReadAuthorizationModelResponse{
+		AuthorizationModel: &model,
+	})
+}
+
+// check returns whether the user has the relation with the object according to the authorization model
+func (s *Server) check(w http.ResponseWriter, r *http.Request) {
+	var req openfga.CheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_tuple", err.Error())
+
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	model, ok := st.model(req.GetAuthorizationModelId())
+	if !ok {
+		writeError(w, http.StatusBadRequest, "latest_authorization_model_not_found", "authorization model not found")
+
+		return
+	}
+
+	allowed, err := newChecker(st, model).check(req.TupleKey.User, req.TupleKey.Relation, req.TupleKey.Object, 0)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, openfga.CheckResponse{
+		Allowed: openfga.PtrBool(allowed),
+	})
+}
+
+// listObjects returns the objects of the type the user has the relation with according to the authorization model,
+// every object of the type in a relationship tuple is checked
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request) {
+	var req openfga.ListObjectsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_tuple", err.Error())
+
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	model, ok := st.model(req.GetAuthorizationModelId())
+	if !ok {
+		writeError(w, http.StatusBadRequest, "latest_authorization_model_not_found", "authorization model not found")
+
+		return
+	}
+
+	c := newChecker(st, model)
+	seen := map[string]bool{}
+
+	resp := openfga.ListObjectsResponse{
+		Objects: []string{},
+	}
+
+	for k := range st.tuples {
+		if seen[k.object] || !strings.HasPrefix(k.object, req.Type+":") {
+			continue
+		}
+
+		seen[k.object] = true
+
+		allowed, err := c.check(req.User, req.Relation, k.object, 0)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+
+			return
+		}
+
+		if allowed {
+			resp.Objects = append(resp.Objects, k.object)
+		}
+	}
+
+	sort.Strings(resp.Objects)
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// read returns the relationship tuples matching the tuple key of the request, the tuples are not paginated;
+// an object without an id, e.g. `todo:`, matches all objects of the type
+func (s *Server) read(w http.ResponseWriter, r *http.Request) {
+	var req openfga.ReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_tuple", err.Error())
+
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	filter := req.GetTupleKey()
+
+	resp := openfga.ReadResponse{
+		Tuples: []openfga.Tuple{},
+	}
+
+	for k, ts := range st.tuples {
+		if filter.User != nil && *filter.User != k.user {
+			continue
+		}
+
+		if filter.Relation != nil && *filter.Relation != k.relation {
+			continue
+		}
+
+		if filter.Object != nil {
+			if strings.HasSuffix(*filter.Object, ":") && !strings.HasPrefix(k.object, *filter.Object) {
+				continue
+			}
+
+			if !strings.HasSuffix(*filter.Object, ":") && *filter.Object != k.object {
+				continue
+			}
+		}
+
+		resp.Tuples = append(resp.Tuples, openfga.Tuple{
+			Key:       openfga.TupleKey{User: k.user, Relation: k.relation, Object: k.object},
+			Timestamp: ts,
+		})
+	}
+
+	sort.Slice(resp.Tuples, func(i, j int) bool {
+		return resp.Tuples[i].Timestamp.Before(resp.Tuples[j].Timestamp)
+	})
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// write adds and removes relationship tuples in a single transaction, like openFGA the whole write fails
+// when a tuple to add already exists or a tuple to remove does not exist
+func (s *Server) write(w http.ResponseWriter, r *http.Request) {
+	var req openfga.WriteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_tuple", err.Error())
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.store(w, r)
+	if !ok {
+		return
+	}
+
+	var writes, deletes []tupleKey
+
+	for _, k := range req.GetWrites().TupleKeys {
+		writes = append(writes, tupleKey{user: k.User, relation: k.Relation, object: k.Object})
+	}
+
+	for _, k := range req.GetDeletes().TupleKeys {
+		deletes = append(deletes, tupleKey{user: k.User, relation: k.Relation, object: k.Object})
+	}
+
+	if len(writes)+len(deletes) > s.maxTuplesPerWrite {
+		writeError(w, http.StatusBadRequest, "exceeded_entity_limit",
+			"number of write operations exceeded the allowed limit of "+strconv.Itoa(s.maxTuplesPerWrite))
+
+		return
+	}
+
+	seen := map[tupleKey]bool{}
+
+	for _, k := range writes {
+		if _, exists := st.tuples[k]; exists || seen[k] {
+			writeError(w, http.StatusBadRequest, "write_failed_due_to_invalid_input",
+				"cannot write a tuple which already exists: user: '"+k.user+"', relation: '"+k.relation+"', object: '"+k.object+"'")
+
+			return
+		}
+
+		seen[k] = true
+	}
+
+	for _, k := range deletes {
+		if _, exists := st.tuples[k]; !exists || seen[k] {
+			writeError(w, http.StatusBadRequest, "write_failed_due_to_invalid_input",
+				"cannot delete a tuple which does not exist: user: '"+k.user+"', relation: '"+k.relation+"', object: '"+k.object+"'")
+
+			return
+		}
+
+		seen[k] = true
+	}
+
+	now := time.Now().UTC()
+
+	for _, k := range deletes {
+		delete(st.tuples, k)
+	}
+
+	for _, k := range writes {
+		st.tuples[k] = now
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// store returns the store of the request, a not found error is written when it does not exist
+func (s *Server) store(w http.ResponseWriter, r *http.Request) (*store, bool) {
+	st, ok := s.stores[r.PathValue("store_id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "store_id_not_found", "store not found")
+	}
+
+	return st, ok
+}
+
+// model returns the authorization model with the id, or the latest model when the id is empty
+func (st *store) model(id string) (openfga.AuthorizationModel, bool) {
+	for _, m := range st.models {
+		if id == "" || m.Id == id {
+			return m, true
+		}
+	}
+
+	return openfga.AuthorizationModel{}, false
+}
+
+// writeJSON writes the value as the json response with the status code
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the format of the openFGA api
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,99 @@
+package bearer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+	"github.com/datumforge/echox/middleware"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/tokens"
+)
+
+// AnonymousAuthentication is the authentication type of requests to public operations without an access token,
+// they have no user or organization so any query or mutation of the graph is denied as an anonymous viewer
+const AnonymousAuthentication auth.AuthenticationType = "anonymous"
+
+// Options for the bearer authentication middleware
+type Options struct {
+	// Validator verifies the signature, audience and issuer of the access tokens
+	Validator tokens.Validator
+	// Revocations is the list of revoked tokens, the tokens are not checked against it when it is not set
+	Revocations RevocationList
+	// PublicOperations are the root fields of graph operations that can be requested without an access token, they
+	// run as an anonymous viewer so only fields that do not query the database are useful, e.g. __schema and __type
+	PublicOperations []string
+	// Skipper defines a function to skip middleware
+	Skipper middleware.Skipper
+}
+
+// RevocationList reports whether the tokens with the id have been revoked before they expired
+type RevocationList interface {
+	IsRevoked(ctx context.Context, id string) (bool, error)
+}
+
+// Authenticate returns a middleware that verifies the bearer access token of the request, or the access token
+// cookie when there is no authorization header, and adds the claims of the token to the auth context.
+// Requests without a token are rejected unless they only request public operations, which run as an anonymous
+// viewer; requests with a token that can not be verified are always rejected
+func Authenticate(conf Options) echo.MiddlewareFunc {
+	if conf.Skipper == nil {
+		conf.Skipper = middleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if conf.Skipper(c) {
+				return next(c)
+			}
+
+			accessToken, err := auth.GetAccessToken(c)
+			if err != nil {
+				if errors.Is(err, auth.ErrNoAuthorization) && isPublicOperation(c.Request(), conf.PublicOperations) {
+					auth.SetAuthenticatedUserContext(c, &auth.AuthenticatedUser{
+						AuthenticationType: AnonymousAuthentication,
+					})
+
+					return next(c)
+				}
+
+				return c.JSON(http.StatusUnauthorized, rout.ErrorResponse(ErrUnauthenticated))
+			}
+
+			claims, err := conf.Validator.Verify(accessToken)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, rout.ErrorResponse(ErrInvalidToken))
+			}
+
+			// refresh tokens do not have the user id and can not be used as access tokens
+			if claims.UserID == "" {
+				return c.JSON(http.StatusUnauthorized, rout.ErrorResponse(ErrInvalidToken))
+			}
+
+			if conf.Revocations != nil {
+				revoked, err := conf.Revocations.IsRevoked(c.Request().Context(), claims.ID)
+				if err != nil {
+					return c.JSON(http.StatusServiceUnavailable, rout.ErrorResponse(ErrRevocationCheck))
+				}
+
+				if revoked {
+					return c.JSON(http.StatusUnauthorized, rout.ErrorResponse(ErrInvalidToken))
+				}
+			}
+
+			auth.SetAuthenticatedUserContext(c, &auth.AuthenticatedUser{
+				SubjectID:          claims.UserID,
+				OrganizationID:     claims.OrgID,
+				OrganizationIDs:    []string{claims.OrgID},
+				AuthenticationType: auth.JWTAuthentication,
+			})
+
+			auth.SetAccessTokenContext(c, accessToken)
+
+			return next(c)
+		}
+	}
+}
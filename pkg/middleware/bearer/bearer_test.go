@@ -0,0 +1,86 @@
+package bearer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/tokens"
+)
+
+// revocationList is a list of revoked token ids, the lookups fail when err is set
+type revocationList struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (l *revocationList) IsRevoked(_ context.Context, id string) (bool, error) {
+	return l.revoked[id], l.err
+}
+
+func TestAuthenticateRevocations(t *testing.T) {
+	validator := &tokens.MockValidator{
+		OnVerify: func(tks string) (*tokens.Claims, error) {
+			claims := &tokens.Claims{UserID: "user_01", OrgID: "org_01"}
+			claims.ID = tks
+
+			return claims, nil
+		},
+	}
+
+	tests := []struct {
+		name        string
+		revocations RevocationList
+		token       string
+		want        int
+	}{
+		{
+			name:        "token is not revoked",
+			revocations: &revocationList{revoked: map[string]bool{"revoked": true}},
+			token:       "valid",
+			want:        http.StatusOK,
+		},
+		{
+			name:        "token is revoked",
+			revocations: &revocationList{revoked: map[string]bool{"revoked": true}},
+			token:       "revoked",
+			want:        http.StatusUnauthorized,
+		},
+		{
+			name:        "revocations can not be checked",
+			revocations: &revocationList{err: errors.New("connection refused")},
+			token:       "valid",
+			want:        http.StatusServiceUnavailable,
+		},
+		{
+			name:  "no revocation list",
+			token: "revoked",
+			want:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+tt.token)
+
+			rec := httptest.NewRecorder()
+
+			mw := Authenticate(Options{Validator: validator, Revocations: tt.revocations})
+			err := mw(func(c echo.Context) error {
+				assert.Equal(t, auth.JWTAuthentication, auth.GetAuthTypeFromEchoContext(c))
+
+				return c.NoContent(http.StatusOK)
+			})(echo.New().NewContext(req, rec))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
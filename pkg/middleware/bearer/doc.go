@@ -0,0 +1,2 @@
+// Package bearer implements a middleware authenticating requests with JWT access tokens issued by the server
+package bearer
@@ -0,0 +1,12 @@
+package bearer
+
+import "errors"
+
+var (
+	// ErrUnauthenticated is returned when the request has no valid access token and is not a public operation
+	ErrUnauthenticated = errors.New("authentication is required for this request")
+	// ErrInvalidToken is returned when the access token can not be verified
+	ErrInvalidToken = errors.New("access token is missing or invalid")
+	// ErrRevocationCheck is returned when the access token can not be checked against the revoked tokens
+	ErrRevocationCheck = errors.New("unable to check the access token, please try again")
+)
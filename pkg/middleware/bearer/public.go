@@ -0,0 +1,87 @@
+package bearer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	echo "github.com/datumforge/echox"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// graphRequest holds the parts of a graph request needed to find the requested operation
+type graphRequest struct {
+	Query         string `json:"query"`
+	OperationName string `json:"operationName"`
+}
+
+// isPublicOperation returns true when the request is a graph query or mutation where every root field is
+// one of the public operations. The body is restored so the graph handler can read it again; requests that
+// can not be parsed, persisted queries without a document and subscriptions are never public
+func isPublicOperation(r *http.Request, public []string) bool {
+	if len(public) == 0 {
+		return false
+	}
+
+	var req graphRequest
+
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+	case http.MethodPost:
+		// uploads are sent as multipart forms and are never public
+		if !strings.HasPrefix(r.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+			return false
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return false
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := json.Unmarshal(body, &req); err != nil {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if req.Query == "" {
+		return false
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		return false
+	}
+
+	var op *ast.OperationDefinition
+
+	switch {
+	case req.OperationName != "":
+		op = doc.Operations.ForName(req.OperationName)
+	case len(doc.Operations) == 1:
+		op = doc.Operations[0]
+	}
+
+	if op == nil || op.Operation == ast.Subscription || len(op.SelectionSet) == 0 {
+		return false
+	}
+
+	// fragments could hide fields that are not public, so only plain fields are allowed
+	for _, sel := range op.SelectionSet {
+		field, ok := sel.(*ast.Field)
+		if !ok || !slices.Contains(public, field.Name) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -4,22 +4,31 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/datumforge/datum/pkg/rout"
 	echo "github.com/datumforge/echox"
 	"go.uber.org/zap"
 
 	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/hooks"
 )
 
 const (
 	rollbackErr          = "error rolling back transaction"
 	transactionStartErr  = "error starting transaction"
 	transactionCommitErr = "error committing transaction"
+
+	// ifMatchHeader holds the version of the object the request expects to change
+	ifMatchHeader = "If-Match"
 )
 
 var (
 	// ErrProcessingRequest is returned when the request cannot be processed
 	ErrProcessingRequest = errors.New("error processing request, please try again")
+	// ErrInvalidIfMatch is returned when the If-Match header does not contain a single version
+	ErrInvalidIfMatch = errors.New("invalid If-Match header, expected the version of the object")
 )
 
 type Client struct {
@@ -29,6 +38,9 @@ type Client struct {
 
 type entClientCtxKey struct{}
 
+// ifMatchCtxKey is the context key of the If-Match header kept by KeepIfMatch
+type ifMatchCtxKey struct{}
+
 // FromContext returns a TX Client stored inside a context, or nil if there isn't one
 func FromContext(ctx context.Context) *ent.Tx {
 	c, _ := ctx.Value(entClientCtxKey{}).(*ent.Tx)
@@ -40,10 +52,18 @@ func NewContext(parent context.Context, c *ent.Tx) context.Context {
 	return context.WithValue(parent, entClientCtxKey{}, c)
 }
 
-// Middleware returns a middleware function for transactions on REST endpoints
+// Middleware returns a middleware function for transactions on REST endpoints; a version conflict returned by
+// the handler is sent as a 412 Precondition Failed. GraphQL requests are not covered: the graph handler writes
+// its errors in the response body and returns nil, so a version conflict of a graph mutation is returned with
+// the CONFLICT code in the errors of the response instead of a 412
 func (d *Client) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		client, err := d.EntDBClient.Tx(c.Request().Context())
+		ctx, err := expectedVersionContext(c.Request())
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, rout.ErrorResponse(ErrInvalidIfMatch))
+		}
+
+		client, err := d.EntDBClient.Tx(ctx)
 		if err != nil {
 			d.Logger.Errorw(transactionStartErr, "error", err)
 
@@ -51,7 +71,7 @@ func (d *Client) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		// add to context
-		ctx := NewContext(c.Request().Context(), client)
+		ctx = NewContext(ctx, client)
 
 		c.SetRequest(c.Request().WithContext(ctx))
 
@@ -64,6 +84,11 @@ func (d *Client) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 				return c.JSON(http.StatusInternalServerError, ErrProcessingRequest)
 			}
 
+			// the object was changed since the version in the If-Match header
+			if errors.Is(err, hooks.ErrVersionConflict) {
+				return c.JSON(http.StatusPreconditionFailed, rout.ErrorResponse(err))
+			}
+
 			return err
 		}
 
@@ -78,3 +103,42 @@ func (d *Client) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 		return nil
 	}
 }
+
+// KeepIfMatch keeps the If-Match header of the request in its context for the transaction middleware, it has to
+// run before the cachecontrol middleware of the server, which removes the entity tag headers from every request
+func KeepIfMatch() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if etag := c.Request().Header.Get(ifMatchHeader); etag != "" {
+				c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), ifMatchCtxKey{}, etag)))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// expectedVersionContext returns the context of the request with the version from the If-Match header
+// as the expected version of the objects changed by the request; the version may be sent as a strong
+// or weak entity tag, a missing header or * does not require a version. The header kept by KeepIfMatch
+// is used when the header was removed from the request
+func expectedVersionContext(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+
+	etag, ok := ctx.Value(ifMatchCtxKey{}).(string)
+	if !ok {
+		etag = r.Header.Get(ifMatchHeader)
+	}
+
+	etag = strings.TrimSpace(etag)
+	if etag == "" || etag == "*" {
+		return ctx, nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(etag, "W/"), `"`))
+	if err != nil || version < 1 {
+		return nil, ErrInvalidIfMatch
+	}
+
+	return hooks.WithExpectedVersion(ctx, version), nil
+}
@@ -0,0 +1,113 @@
+package transaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/datumforge/datum/pkg/rout"
+	echo "github.com/datumforge/echox"
+	_ "github.com/datumforge/entx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	ent "github.com/datumforge/go-template/internal/ent/generated"
+	"github.com/datumforge/go-template/internal/ent/hooks"
+)
+
+func TestMiddleware(t *testing.T) {
+	client, err := ent.Open(dialect.SQLite, "file:transaction?mode=memory&_fk=1")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	tc := &Client{
+		EntDBClient: client,
+		Logger:      zap.NewNop().Sugar(),
+	}
+
+	tests := []struct {
+		name        string
+		ifMatch     string
+		handlerErr  error
+		wantStatus  int
+		wantError   string
+		wantVersion int
+	}{
+		{
+			name:       "no If-Match header",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "If-Match version",
+			ifMatch:     `"3"`,
+			wantStatus:  http.StatusOK,
+			wantVersion: 3,
+		},
+		{
+			name:        "weak If-Match version",
+			ifMatch:     `W/"3"`,
+			wantStatus:  http.StatusOK,
+			wantVersion: 3,
+		},
+		{
+			name:       "invalid If-Match header",
+			ifMatch:    `"latest"`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  ErrInvalidIfMatch.Error(),
+		},
+		{
+			name:        "version conflict",
+			ifMatch:     `"3"`,
+			handlerErr:  fmt.Errorf("updating todo: %w", hooks.ErrVersionConflict),
+			wantStatus:  http.StatusPreconditionFailed,
+			wantError:   "updating todo: " + hooks.ErrVersionConflict.Error(),
+			wantVersion: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set(ifMatchHeader, tt.ifMatch)
+			}
+
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			handler := tc.Middleware(func(c echo.Context) error {
+				ctx := c.Request().Context()
+
+				assert.NotNil(t, FromContext(ctx))
+
+				version, ok := hooks.ExpectedVersionFromContext(ctx)
+				assert.Equal(t, tt.wantVersion != 0, ok)
+				assert.Equal(t, tt.wantVersion, version)
+
+				if tt.handlerErr != nil {
+					return tt.handlerErr
+				}
+
+				return c.NoContent(http.StatusOK)
+			})
+
+			require.NoError(t, handler(c))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantError == "" {
+				return
+			}
+
+			var reply rout.Reply
+
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &reply))
+			assert.False(t, reply.Success)
+			assert.Equal(t, tt.wantError, reply.Error)
+		})
+	}
+}
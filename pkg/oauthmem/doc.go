@@ -0,0 +1,4 @@
+// Package oauthmem is an in-memory stand-in for the GitHub and Google oauth2 providers, it implements the
+// authorization code flow with PKCE and the user endpoints used by the login handlers so logins can be
+// exercised without registering an application with the providers
+package oauthmem
@@ -0,0 +1,267 @@
+package oauthmem
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/datumforge/datum/pkg/keygen"
+)
+
+// codeLength is the length of the authorization codes and access tokens
+const codeLength = 32
+
+// User is the user that authorizes the logins with the server
+type User struct {
+	// Login is the GitHub username of the user
+	Login string
+	// Name is the display name of the user
+	Name string
+	// Email is the primary email address of the user
+	Email string
+	// Verified is whether the email address is verified
+	Verified bool
+}
+
+// Server serves the oauth2 endpoints of a provider from memory, every authorization is approved for the user
+type Server struct {
+	mu sync.Mutex
+	// clientID and clientSecret are the credentials of the only client of the server
+	clientID     string
+	clientSecret string
+	// user is the user authorizing the logins
+	user User
+	// codes are the PKCE challenges of the authorization codes that were not exchanged yet
+	codes map[string]string
+	// tokens are the access tokens that were issued
+	tokens map[string]bool
+	// srv is the http server listening on a local port
+	srv *httptest.Server
+}
+
+// NewServer starts a new in-memory oauth2 provider listening on a local port for the client
+func NewServer(clientID, clientSecret string) *Server {
+	s := &Server{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		codes:        map[string]string{},
+		tokens:       map[string]bool{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /authorize", s.authorize)
+	mux.HandleFunc("POST /token", s.token)
+	mux.HandleFunc("GET /user", s.githubUser)
+	mux.HandleFunc("GET /user/emails", s.githubEmails)
+	mux.HandleFunc("GET /oauth2/v2/userinfo", s.googleUser)
+
+	s.srv = httptest.NewServer(mux)
+
+	return s
+}
+
+// URL returns the base url of the server, the user endpoints of both providers are served from it
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// AuthURL returns the url of the authorization endpoint
+func (s *Server) AuthURL() string {
+	return s.srv.URL + "/authorize"
+}
+
+// TokenURL returns the url of the token endpoint
+func (s *Server) TokenURL() string {
+	return s.srv.URL + "/token"
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// SetUser sets the user authorizing the following logins
+func (s *Server) SetUser(u User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.user = u
+}
+
+// authorize approves the authorization request and redirects back to the client with a code
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	if q.Get("client_id") != s.clientID || q.Get("response_type") != "code" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+
+		return
+	}
+
+	// only S256 challenges are accepted, like the providers the verifier is required once a challenge is sent
+	if q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+
+		return
+	}
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || !redirect.IsAbs() {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+
+		return
+	}
+
+	code := keygen.GenerateRandomString(codeLength)
+
+	s.mu.Lock()
+	s.codes[code] = q.Get("code_challenge")
+	s.mu.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// token exchanges an authorization code for an access token, the code can only be exchanged once
+// and only with the verifier of its challenge
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request")
+
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if clientID != s.clientID || subtle.ConstantTimeCompare([]byte(clientSecret), []byte(s.clientSecret)) != 1 {
+		writeError(w, http.StatusUnauthorized, "invalid_client")
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := r.PostForm.Get("code")
+
+	challenge, ok := s.codes[code]
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeError(w, http.StatusBadRequest, "invalid_grant")
+
+		return
+	}
+
+	delete(s.codes, code)
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+		writeError(w, http.StatusBadRequest, "invalid_grant")
+
+		return
+	}
+
+	token := keygen.GenerateRandomString(codeLength)
+	s.tokens[token] = true
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"token_type":   "bearer",
+		"expires_in":   3600, //nolint:mnd
+	})
+}
+
+// githubUser returns the user in the format of the GitHub api, the email address is only set when verified
+func (s *Server) githubUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.authorized(w, r)
+	if !ok {
+		return
+	}
+
+	resp := map[string]any{
+		"id":    1,
+		"login": u.Login,
+		"name":  u.Name,
+		"email": nil,
+	}
+
+	if u.Verified {
+		resp["email"] = u.Email
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// githubEmails returns the email addresses of the user in the format of the GitHub api
+func (s *Server) githubEmails(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.authorized(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, []map[string]any{
+		{
+			"email":    u.Email,
+			"primary":  true,
+			"verified": u.Verified,
+		},
+	})
+}
+
+// googleUser returns the user in the format of the Google userinfo api
+func (s *Server) googleUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.authorized(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":             "1",
+		"email":          u.Email,
+		"verified_email": u.Verified,
+		"name":           u.Name,
+	})
+}
+
+// authorized returns the user when the request has an access token issued by the server,
+// an unauthorized error is written otherwise
+func (s *Server) authorized(w http.ResponseWriter, r *http.Request) (User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || !s.tokens[token] {
+		writeError(w, http.StatusUnauthorized, "invalid_token")
+
+		return User{}, false
+	}
+
+	return s.user, true
+}
+
+// writeJSON writes the value as the json response with the status code
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the format of the oauth2 token endpoint
+func writeError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, map[string]string{
+		"error": code,
+	})
+}
@@ -0,0 +1,3 @@
+// Package revocation keeps the ids of revoked tokens in redis until the tokens expire, so tokens can be
+// rejected before their expiration and are rejected by every server replica
+package revocation
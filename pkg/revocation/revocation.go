@@ -0,0 +1,53 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix is the prefix of the redis keys of the revoked token ids
+const keyPrefix = "revoked_token:"
+
+// ErrExpired is returned when revoking tokens that have already expired, they can not be used anymore
+var ErrExpired = errors.New("tokens have already expired")
+
+// List is the list of revoked tokens, each id is kept until the tokens with the id have expired
+type List struct {
+	client *redis.Client
+}
+
+// New returns the revocation list stored in redis
+func New(client *redis.Client) *List {
+	return &List{
+		client: client,
+	}
+}
+
+// Revoke adds the token id to the list until the time the tokens with the id expire, it returns
+// false when the id was already revoked; tokens that are already expired are not added, ErrExpired
+// is returned instead
+func (l *List) Revoke(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return false, ErrExpired
+	}
+
+	return l.client.SetNX(ctx, keyPrefix+id, expiresAt.Unix(), ttl).Result()
+}
+
+// IsRevoked returns whether the token id is in the list
+func (l *List) IsRevoked(ctx context.Context, id string) (bool, error) {
+	err := l.client.Get(ctx, keyPrefix+id).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
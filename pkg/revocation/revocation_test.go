@@ -0,0 +1,133 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestList returns a revocation list stored in an in-memory redis server
+func newTestList(t *testing.T) (*List, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return New(client), mr
+}
+
+func TestRevoke(t *testing.T) {
+	l, mr := newTestList(t)
+	ctx := context.Background()
+
+	_, err := l.Revoke(ctx, "revoked", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		id        string
+		expiresAt time.Time
+		want      bool
+		wantErr   error
+		wantKey   bool
+	}{
+		{
+			name:      "new id",
+			id:        "new",
+			expiresAt: time.Now().Add(time.Hour),
+			want:      true,
+			wantKey:   true,
+		},
+		{
+			name:      "already revoked",
+			id:        "revoked",
+			expiresAt: time.Now().Add(time.Hour),
+			want:      false,
+			wantKey:   true,
+		},
+		{
+			name:      "expired",
+			id:        "expired",
+			expiresAt: time.Now().Add(-time.Minute),
+			want:      false,
+			wantErr:   ErrExpired,
+		},
+		{
+			name:    "expiring now",
+			id:      "now",
+			want:    false,
+			wantErr: ErrExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revoked, err := l.Revoke(ctx, tt.id, tt.expiresAt)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, revoked)
+			assert.Equal(t, tt.wantKey, mr.Exists(keyPrefix+tt.id))
+		})
+	}
+
+	t.Run("key expires with the tokens", func(t *testing.T) {
+		_, err := l.Revoke(ctx, "ttl", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		ttl := mr.TTL(keyPrefix + "ttl")
+		assert.Greater(t, ttl, 59*time.Minute)
+		assert.LessOrEqual(t, ttl, time.Hour)
+	})
+}
+
+func TestIsRevoked(t *testing.T) {
+	l, mr := newTestList(t)
+	ctx := context.Background()
+
+	_, err := l.Revoke(ctx, "revoked", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{
+			name: "revoked",
+			id:   "revoked",
+			want: true,
+		},
+		{
+			name: "not revoked",
+			id:   "valid",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revoked, err := l.IsRevoked(ctx, tt.id)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, revoked)
+		})
+	}
+
+	t.Run("redis is not reachable", func(t *testing.T) {
+		mr.Close()
+
+		_, err := l.IsRevoked(ctx, "revoked")
+		assert.Error(t, err)
+	})
+}